@@ -0,0 +1,105 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+
+	"github.com/tmc/langchaingo/embeddings"
+)
+
+// ErrWrongNumberVectors is returned when the wrapped Embedder returns a
+// different number of vectors than texts it was given.
+var ErrWrongNumberVectors = errors.New("number of vectors from embedder does not match number of texts")
+
+// Backend is the interface that needs to be implemented by cache backends.
+type Backend interface {
+	// Get a value from the cache. If the key is not found, return `nil`.
+	Get(ctx context.Context, key string) []float32
+	// Put a value into the cache.
+	Put(ctx context.Context, key string, vector []float32)
+}
+
+// Cacher is an Embedder wrapper that caches the vectors returned by the
+// Embedder.
+type Cacher struct {
+	embedder embeddings.Embedder
+	cache    Backend
+	model    string
+}
+
+// assert that `Cacher` implements the `embeddings.Embedder` interface.
+var _ embeddings.Embedder = (*Cacher)(nil)
+
+// New wraps an Embedder and adds caching capabilities using the provided
+// cache backend. The model name is part of every cache key, so that a backend
+// shared by several embedders never returns vectors from the wrong model.
+func New(embedder embeddings.Embedder, backend Backend, model string) *Cacher {
+	return &Cacher{
+		embedder: embedder,
+		cache:    backend,
+		model:    model,
+	}
+}
+
+// EmbedDocuments returns a vector for each text. Only the texts missing from
+// the cache are sent to the wrapped Embedder, in a single call.
+func (c *Cacher) EmbedDocuments(ctx context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, len(texts))
+	keys := make([]string, len(texts))
+	var missing []int
+	var missingTexts []string
+	for i, text := range texts {
+		keys[i] = c.key("document", text)
+		if vector := c.cache.Get(ctx, keys[i]); vector != nil {
+			vectors[i] = vector
+			continue
+		}
+		missing = append(missing, i)
+		missingTexts = append(missingTexts, text)
+	}
+
+	if len(missing) == 0 {
+		return vectors, nil
+	}
+
+	embedded, err := c.embedder.EmbedDocuments(ctx, missingTexts)
+	if err != nil {
+		return nil, err
+	}
+	if len(embedded) != len(missing) {
+		return nil, ErrWrongNumberVectors
+	}
+
+	for j, i := range missing {
+		vectors[i] = embedded[j]
+		c.cache.Put(ctx, keys[i], embedded[j])
+	}
+	return vectors, nil
+}
+
+// EmbedQuery embeds a single text. Query vectors are cached separately from
+// document vectors, since some models embed the two differently.
+func (c *Cacher) EmbedQuery(ctx context.Context, text string) ([]float32, error) {
+	key := c.key("query", text)
+	if vector := c.cache.Get(ctx, key); vector != nil {
+		return vector, nil
+	}
+
+	vector, err := c.embedder.EmbedQuery(ctx, text)
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.Put(ctx, key, vector)
+
+	return vector, nil
+}
+
+// key is a helper function that generates a unique key for a text embedded
+// by the cached model.
+func (c *Cacher) key(kind, text string) string {
+	hash := sha256.Sum256([]byte(text))
+	return c.model + ":" + kind + ":" + hex.EncodeToString(hash[:])
+}
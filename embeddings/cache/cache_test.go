@@ -0,0 +1,63 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tmc/langchaingo/embeddings/cache"
+	"github.com/tmc/langchaingo/embeddings/cache/inmemory"
+)
+
+type countingEmbedder struct {
+	texts []string
+}
+
+func (e *countingEmbedder) EmbedDocuments(_ context.Context, texts []string) ([][]float32, error) {
+	e.texts = append(e.texts, texts...)
+	vectors := make([][]float32, len(texts))
+	for i, text := range texts {
+		vectors[i] = []float32{float32(len(text))}
+	}
+	return vectors, nil
+}
+
+func (e *countingEmbedder) EmbedQuery(_ context.Context, text string) ([]float32, error) {
+	e.texts = append(e.texts, text)
+	return []float32{-float32(len(text))}, nil
+}
+
+func TestCacher(t *testing.T) {
+	ctx := context.Background()
+	t.Parallel()
+	rq := require.New(t)
+
+	backend, err := inmemory.New(ctx)
+	rq.NoError(err)
+
+	embedder := &countingEmbedder{}
+	cached := cache.New(embedder, backend, "model-a")
+
+	vectors, err := cached.EmbedDocuments(ctx, []string{"a", "bb"})
+	rq.NoError(err)
+	rq.Equal([][]float32{{1}, {2}}, vectors)
+
+	vectors, err = cached.EmbedDocuments(ctx, []string{"bb", "ccc", "a"})
+	rq.NoError(err)
+	rq.Equal([][]float32{{2}, {3}, {1}}, vectors)
+	rq.Equal([]string{"a", "bb", "ccc"}, embedder.texts, "only missing texts should be embedded")
+
+	// Query vectors are cached separately from document vectors.
+	vector, err := cached.EmbedQuery(ctx, "a")
+	rq.NoError(err)
+	rq.Equal([]float32{-1}, vector)
+	_, err = cached.EmbedQuery(ctx, "a")
+	rq.NoError(err)
+	rq.Equal([]string{"a", "bb", "ccc", "a"}, embedder.texts)
+
+	// A different model sharing the backend does not see these entries.
+	other := &countingEmbedder{}
+	_, err = cache.New(other, backend, "model-b").EmbedDocuments(ctx, []string{"a"})
+	rq.NoError(err)
+	rq.Equal([]string{"a"}, other.texts)
+}
@@ -0,0 +1,6 @@
+// Package cache provides a wrapper that adds caching to an `embeddings.Embedder`. Vectors are
+// cached under a key calculated from the model name, the kind of embedding (document or query)
+// and a hash of the text, so repeated ingests of the same content skip the embedding call.
+// Different cache backends can be used when creating the wrapper, and since the wrapper is
+// itself an Embedder it can be passed to any vector store.
+package cache
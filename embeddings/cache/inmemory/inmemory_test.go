@@ -0,0 +1,29 @@
+package inmemory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInMemory(t *testing.T) {
+	ctx := context.Background()
+	t.Parallel()
+	rq := require.New(t)
+
+	cache, err := New(ctx, WithCapacity(2))
+	rq.NoError(err)
+
+	rq.Nil(cache.Get(ctx, "key1"), "empty cache should be empty")
+
+	cache.Put(ctx, "key1", []float32{1})
+	cache.Put(ctx, "key2", []float32{2})
+	rq.Equal([]float32{1}, cache.Get(ctx, "key1"))
+
+	// key1 was used more recently, so key2 is evicted.
+	cache.Put(ctx, "key3", []float32{3})
+	rq.Nil(cache.Get(ctx, "key2"), "least recently used value should have been evicted")
+	rq.NotNil(cache.Get(ctx, "key1"))
+	rq.NotNil(cache.Get(ctx, "key3"))
+}
@@ -0,0 +1,217 @@
+package benchmark
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tmc/langchaingo/schema"
+	"github.com/tmc/langchaingo/vectorstores"
+)
+
+const (
+	defaultBatchSize    = 100
+	defaultNumDocuments = 4
+	defaultConcurrency  = 1
+)
+
+// Workload describes the operations performed by Run.
+type Workload struct {
+	// Documents are added to the store in batches of BatchSize.
+	Documents []schema.Document
+	// Queries are searched for once each after ingestion, NumDocuments results
+	// at a time, by Concurrency concurrent workers.
+	Queries      []string
+	BatchSize    int
+	NumDocuments int
+	Concurrency  int
+	// Options are passed to every AddDocuments and SimilaritySearch call,
+	// for example to isolate the run in its own namespace.
+	Options []vectorstores.Option
+}
+
+// Stats summarizes one phase of a run.
+type Stats struct {
+	Operations int
+	Errors     int
+	// Items is the number of documents added or queries run.
+	Items    int
+	Duration time.Duration
+	Mean     time.Duration
+	P50      time.Duration
+	P95      time.Duration
+	P99      time.Duration
+	Max      time.Duration
+}
+
+// Throughput returns the number of items processed per second.
+func (s Stats) Throughput() float64 {
+	if s.Duration <= 0 {
+		return 0
+	}
+	return float64(s.Items) / s.Duration.Seconds()
+}
+
+// String formats the statistics on a single line.
+func (s Stats) String() string {
+	return fmt.Sprintf("%d ops (%d errors), %.1f items/s, mean %v, p50 %v, p95 %v, p99 %v, max %v",
+		s.Operations, s.Errors, s.Throughput(), s.Mean, s.P50, s.P95, s.P99, s.Max)
+}
+
+// Result holds the statistics of both phases of a run.
+type Result struct {
+	Ingest Stats
+	Query  Stats
+}
+
+// Run adds the workload's documents to store and then runs its queries,
+// timing every call. Failed calls are counted in Stats.Errors rather than
+// stopping the run; Run only returns an error if ctx is done.
+func Run(ctx context.Context, store vectorstores.VectorStore, w Workload) (Result, error) {
+	w = withDefaults(w)
+
+	var result Result
+	var err error
+	if result.Ingest, err = ingest(ctx, store, w); err != nil {
+		return result, err
+	}
+	result.Query, err = query(ctx, store, w)
+	return result, err
+}
+
+func withDefaults(w Workload) Workload {
+	if w.BatchSize <= 0 {
+		w.BatchSize = defaultBatchSize
+	}
+	if w.NumDocuments <= 0 {
+		w.NumDocuments = defaultNumDocuments
+	}
+	if w.Concurrency <= 0 {
+		w.Concurrency = defaultConcurrency
+	}
+	return w
+}
+
+func ingest(ctx context.Context, store vectorstores.VectorStore, w Workload) (Stats, error) {
+	var latencies []time.Duration
+	errs := 0
+	start := time.Now()
+	for batch := range slices.Chunk(w.Documents, w.BatchSize) {
+		if err := ctx.Err(); err != nil {
+			return Stats{}, err
+		}
+		t := time.Now()
+		if _, err := store.AddDocuments(ctx, batch, w.Options...); err != nil {
+			errs++
+		}
+		latencies = append(latencies, time.Since(t))
+	}
+	return summarize(latencies, errs, len(w.Documents), time.Since(start)), nil
+}
+
+func query(ctx context.Context, store vectorstores.VectorStore, w Workload) (Stats, error) {
+	queries := make(chan string)
+	var mu sync.Mutex
+	var latencies []time.Duration
+	errs := 0
+
+	var wg sync.WaitGroup
+	start := time.Now()
+	for range w.Concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for q := range queries {
+				t := time.Now()
+				_, err := store.SimilaritySearch(ctx, q, w.NumDocuments, w.Options...)
+				d := time.Since(t)
+
+				mu.Lock()
+				latencies = append(latencies, d)
+				if err != nil {
+					errs++
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	var err error
+	for _, q := range w.Queries {
+		if err = ctx.Err(); err != nil {
+			break
+		}
+		queries <- q
+	}
+	close(queries)
+	wg.Wait()
+	if err != nil {
+		return Stats{}, err
+	}
+	return summarize(latencies, errs, len(w.Queries), time.Since(start)), nil
+}
+
+func summarize(latencies []time.Duration, errs, items int, elapsed time.Duration) Stats {
+	s := Stats{Operations: len(latencies), Errors: errs, Items: items, Duration: elapsed}
+	if len(latencies) == 0 {
+		return s
+	}
+
+	slices.Sort(latencies)
+	var total time.Duration
+	for _, d := range latencies {
+		total += d
+	}
+	s.Mean = total / time.Duration(len(latencies))
+	s.P50 = percentile(latencies, 50)
+	s.P95 = percentile(latencies, 95)
+	s.P99 = percentile(latencies, 99)
+	s.Max = latencies[len(latencies)-1]
+	return s
+}
+
+// percentile returns the nearest-rank percentile p of the sorted latencies.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := (p*len(sorted) + 99) / 100
+	return sorted[max(rank, 1)-1]
+}
+
+var vocabulary = strings.Fields(`
+	alpha archive bridge cache city cloud coast data desert engine forest
+	garden graph harbor index island kernel lake market matrix model mountain
+	network ocean orbit planet query river road signal stream system tower
+	train valley vector village window winter`)
+
+// SyntheticWorkload returns a reproducible workload of numDocs documents of
+// random words and numQueries queries drawn from the same vocabulary. The
+// same seed always yields the same workload, so that it can be replayed
+// against every backend under test.
+func SyntheticWorkload(numDocs, numQueries int, seed uint64) Workload {
+	r := rand.New(rand.NewPCG(seed, seed)) //nolint:gosec
+	sentence := func(words int) string {
+		parts := make([]string, words)
+		for i := range parts {
+			parts[i] = vocabulary[r.IntN(len(vocabulary))]
+		}
+		return strings.Join(parts, " ")
+	}
+
+	w := Workload{
+		Documents: make([]schema.Document, numDocs),
+		Queries:   make([]string, numQueries),
+	}
+	for i := range w.Documents {
+		w.Documents[i] = schema.Document{
+			PageContent: sentence(20 + r.IntN(40)),
+			Metadata:    map[string]any{"index": i},
+		}
+	}
+	for i := range w.Queries {
+		w.Queries[i] = sentence(3 + r.IntN(5))
+	}
+	return w
+}
@@ -0,0 +1,69 @@
+package benchmark_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tmc/langchaingo/schema"
+	"github.com/tmc/langchaingo/vectorstores"
+	"github.com/tmc/langchaingo/vectorstores/benchmark"
+)
+
+type sleepyStore struct {
+	added    atomic.Int64
+	searches atomic.Int64
+}
+
+func (s *sleepyStore) AddDocuments(_ context.Context, docs []schema.Document, _ ...vectorstores.Option) ([]string, error) {
+	time.Sleep(time.Millisecond)
+	s.added.Add(int64(len(docs)))
+	return make([]string, len(docs)), nil
+}
+
+func (s *sleepyStore) SimilaritySearch(_ context.Context, query string, _ int, _ ...vectorstores.Option) ([]schema.Document, error) {
+	time.Sleep(time.Millisecond)
+	if s.searches.Add(1) == 1 {
+		return nil, errors.New("transient failure")
+	}
+	return nil, nil
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	workload := benchmark.SyntheticWorkload(250, 20, 1)
+	workload.Concurrency = 4
+
+	store := &sleepyStore{}
+	result, err := benchmark.Run(context.Background(), store, workload)
+	require.NoError(t, err)
+
+	assert.EqualValues(t, 250, store.added.Load())
+	assert.Equal(t, 3, result.Ingest.Operations)
+	assert.Equal(t, 250, result.Ingest.Items)
+	assert.Zero(t, result.Ingest.Errors)
+	assert.GreaterOrEqual(t, result.Ingest.P50, time.Millisecond)
+	assert.Positive(t, result.Ingest.Throughput())
+
+	assert.EqualValues(t, 20, store.searches.Load())
+	assert.Equal(t, 20, result.Query.Operations)
+	assert.Equal(t, 1, result.Query.Errors)
+	assert.LessOrEqual(t, result.Query.P50, result.Query.P99)
+	assert.LessOrEqual(t, result.Query.P99, result.Query.Max)
+}
+
+func TestSyntheticWorkload(t *testing.T) {
+	t.Parallel()
+
+	a := benchmark.SyntheticWorkload(10, 5, 42)
+	b := benchmark.SyntheticWorkload(10, 5, 42)
+	assert.Equal(t, a, b)
+	assert.Len(t, a.Documents, 10)
+	assert.Len(t, a.Queries, 5)
+	assert.NotEqual(t, a, benchmark.SyntheticWorkload(10, 5, 43))
+}
@@ -0,0 +1,19 @@
+// Package benchmark runs the same ingestion and query workload against any
+// VectorStore and reports throughput and latency, so that backends can be
+// compared on equal terms.
+//
+// A Workload is built once, for example with SyntheticWorkload, and passed to
+// Run for each store under test:
+//
+//	workload := benchmark.SyntheticWorkload(10000, 500, 1)
+//	result, err := benchmark.Run(ctx, store, workload)
+//	if err != nil {
+//		return err
+//	}
+//	fmt.Println("ingest:", result.Ingest)
+//	fmt.Println("query: ", result.Query)
+//
+// The embedder configured on the store is part of what is measured. To
+// compare databases alone, configure every store with the same fast local
+// embedder.
+package benchmark
@@ -0,0 +1,8 @@
+// Package falkordb contains an implementation of the VectorStore
+// interface using FalkorDB.
+//
+// FalkorDB is a graph database module for Redis. Documents are stored as
+// nodes in a named graph and searched through a vector index queried with
+// GRAPH.QUERY, so any Redis deployment running the FalkorDB module can be
+// used.
+package falkordb
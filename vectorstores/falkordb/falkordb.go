@@ -0,0 +1,303 @@
+package falkordb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/redis/rueidis"
+	"github.com/tmc/langchaingo/embeddings"
+	"github.com/tmc/langchaingo/schema"
+	"github.com/tmc/langchaingo/vectorstores"
+)
+
+var (
+	ErrEmbedderWrongNumberVectors = errors.New("number of vectors from embedder does not match number of documents")
+	ErrInvalidScoreThreshold      = errors.New("score threshold must be between 0 and 1")
+	ErrUnsupportedOptions         = errors.New("unsupported options")
+)
+
+const (
+	metadataProperty  = "metadata"
+	namespaceProperty = "namespace"
+)
+
+// Store is a wrapper around a Redis client talking to FalkorDB.
+type Store struct {
+	embedder           embeddings.Embedder
+	client             rueidis.Client
+	ownsClient         bool
+	connURL            string
+	graphName          string
+	nodeLabel          string
+	textProperty       string
+	embeddingProperty  string
+	similarityFunction string
+	vectorDimensions   int
+	fetchMultiplier    int
+}
+
+var _ vectorstores.VectorStore = Store{}
+
+// New creates a new Store with options. If the vector dimensions are known the
+// vector index is created up front.
+func New(ctx context.Context, opts ...Option) (Store, error) {
+	store, err := applyClientOptions(opts...)
+	if err != nil {
+		return Store{}, err
+	}
+	if store.client == nil {
+		clientOption, err := rueidis.ParseURL(store.connURL)
+		if err != nil {
+			return Store{}, err
+		}
+		store.client, err = rueidis.NewClient(clientOption)
+		if err != nil {
+			return Store{}, err
+		}
+		store.ownsClient = true
+	}
+	if store.vectorDimensions > 0 {
+		if err := store.ensureIndex(ctx, store.vectorDimensions); err != nil {
+			return Store{}, err
+		}
+	}
+	return store, nil
+}
+
+// Close closes the client if it was created by the store.
+func (s Store) Close() {
+	if s.ownsClient {
+		s.client.Close()
+	}
+}
+
+// AddDocuments adds documents as nodes to the graph and returns the ids of the
+// added documents. Metadata is stored as a JSON string property.
+func (s Store) AddDocuments(
+	ctx context.Context,
+	docs []schema.Document,
+	options ...vectorstores.Option,
+) ([]string, error) {
+	opts := s.getOptions(options...)
+	if opts.Filters != nil {
+		return nil, ErrUnsupportedOptions
+	}
+
+	docs = s.deduplicate(ctx, opts, docs)
+	if len(docs) == 0 {
+		return []string{}, nil
+	}
+
+	texts := make([]string, 0, len(docs))
+	for _, doc := range docs {
+		texts = append(texts, doc.PageContent)
+	}
+
+	vectors, err := s.getEmbedder(opts).EmbedDocuments(ctx, texts)
+	if err != nil {
+		return nil, err
+	}
+	if len(vectors) != len(docs) {
+		return nil, ErrEmbedderWrongNumberVectors
+	}
+
+	dims := s.vectorDimensions
+	if dims == 0 {
+		dims = len(vectors[0])
+	}
+	if err := s.ensureIndex(ctx, dims); err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(docs))
+	rows := make([]any, 0, len(docs))
+	for i, doc := range docs {
+		ids[i] = uuid.New().String()
+		metadata, err := json.Marshal(doc.Metadata)
+		if err != nil {
+			return nil, err
+		}
+		row := map[string]any{
+			"id":        ids[i],
+			"text":      doc.PageContent,
+			"metadata":  string(metadata),
+			"embedding": vectors[i],
+		}
+		if opts.NameSpace != "" {
+			row["namespace"] = opts.NameSpace
+		}
+		rows = append(rows, row)
+	}
+
+	query := fmt.Sprintf(`UNWIND $rows AS row
+CREATE (n:%s {id: row.id})
+SET n.%s = row.text, n.%s = row.metadata, n.%s = row.namespace, n.%s = vecf32(row.embedding)`,
+		quote(s.nodeLabel), quote(s.textProperty), metadataProperty, namespaceProperty, quote(s.embeddingProperty))
+
+	if _, err := s.graphQuery(ctx, query, map[string]any{"rows": rows}); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// SimilaritySearch performs a vector similarity search. The returned scores are
+// 1-distance for the cosine similarity function and 1/(1+distance) for
+// euclidean, so higher is always more similar.
+func (s Store) SimilaritySearch(
+	ctx context.Context,
+	query string,
+	numDocuments int,
+	options ...vectorstores.Option,
+) ([]schema.Document, error) {
+	opts := s.getOptions(options...)
+	if opts.Filters != nil {
+		return nil, ErrUnsupportedOptions
+	}
+	scoreThreshold, err := s.getScoreThreshold(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	vector, err := s.getEmbedder(opts).EmbedQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	cypher, params := s.buildSearchQuery(vector, numDocuments, opts.NameSpace)
+	result, err := s.graphQuery(ctx, cypher, params)
+	if err != nil {
+		return nil, err
+	}
+
+	textCol, metadataCol, distanceCol := result.column("text"), result.column("metadata"), result.column("distance")
+	if textCol < 0 || metadataCol < 0 || distanceCol < 0 {
+		return nil, fmt.Errorf("unexpected result columns %v", result.columns)
+	}
+
+	docs := make([]schema.Document, 0, len(result.rows))
+	for _, row := range result.rows {
+		distance, err := row[distanceCol].AsFloat64()
+		if err != nil {
+			return nil, err
+		}
+		score := s.score(distance)
+		if scoreThreshold != 0 && score < scoreThreshold {
+			continue
+		}
+
+		doc := schema.Document{Score: score}
+		if !row[textCol].IsNil() {
+			if doc.PageContent, err = row[textCol].ToString(); err != nil {
+				return nil, err
+			}
+		}
+		if !row[metadataCol].IsNil() {
+			metadata, err := row[metadataCol].ToString()
+			if err != nil {
+				return nil, err
+			}
+			if err := json.Unmarshal([]byte(metadata), &doc.Metadata); err != nil {
+				return nil, err
+			}
+		}
+		docs = append(docs, doc)
+		if len(docs) == numDocuments {
+			break
+		}
+	}
+	return docs, nil
+}
+
+func (s Store) buildSearchQuery(vector []float32, numDocuments int, namespace string) (string, map[string]any) {
+	params := map[string]any{
+		"label":    s.nodeLabel,
+		"property": s.embeddingProperty,
+		"vector":   vector,
+		"k":        numDocuments,
+	}
+
+	// Over-fetch so that post-filtering by namespace still leaves enough hits.
+	limit := numDocuments * s.fetchMultiplier
+
+	var b strings.Builder
+	b.WriteString("CALL db.idx.vector.queryNodes($label, $property, $limit, vecf32($vector)) YIELD node, score\n")
+	if namespace != "" {
+		b.WriteString("WHERE node." + namespaceProperty + " = $namespace\n")
+		params["namespace"] = namespace
+	} else {
+		limit = numDocuments
+	}
+	params["limit"] = limit
+	fmt.Fprintf(&b, "RETURN node.%s AS text, node.%s AS metadata, score AS distance\n",
+		quote(s.textProperty), metadataProperty)
+	b.WriteString("ORDER BY distance ASC\nLIMIT $k")
+	return b.String(), params
+}
+
+func (s Store) score(distance float64) float32 {
+	if s.similarityFunction == "euclidean" {
+		return float32(1 / (1 + distance))
+	}
+	return float32(1 - distance)
+}
+
+// DeleteGraph removes the graph, including all documents and the vector index.
+func (s Store) DeleteGraph(ctx context.Context) error {
+	return s.client.Do(ctx, s.client.B().Arbitrary("GRAPH.DELETE").Keys(s.graphName).Build()).Error()
+}
+
+func (s Store) ensureIndex(ctx context.Context, dims int) error {
+	// Index identifiers cannot be passed as parameters.
+	query := fmt.Sprintf("CREATE VECTOR INDEX FOR (n:%s) ON (n.%s) OPTIONS {dimension: %d, similarityFunction: '%s'}",
+		quote(s.nodeLabel), quote(s.embeddingProperty), dims, s.similarityFunction)
+	_, err := s.graphQuery(ctx, query, nil)
+	if err != nil && strings.Contains(err.Error(), "already indexed") {
+		return nil
+	}
+	return err
+}
+
+func (s Store) getOptions(options ...vectorstores.Option) vectorstores.Options {
+	opts := vectorstores.Options{}
+	for _, opt := range options {
+		opt(&opts)
+	}
+	return opts
+}
+
+func (s Store) getEmbedder(opts vectorstores.Options) embeddings.Embedder {
+	if opts.Embedder != nil {
+		return opts.Embedder
+	}
+	return s.embedder
+}
+
+func (s Store) getScoreThreshold(opts vectorstores.Options) (float32, error) {
+	if opts.ScoreThreshold < 0 || opts.ScoreThreshold > 1 {
+		return 0, ErrInvalidScoreThreshold
+	}
+	return opts.ScoreThreshold, nil
+}
+
+func (s Store) deduplicate(
+	ctx context.Context,
+	opts vectorstores.Options,
+	docs []schema.Document,
+) []schema.Document {
+	if opts.Deduplicater == nil {
+		return docs
+	}
+
+	filtered := make([]schema.Document, 0, len(docs))
+	for _, doc := range docs {
+		if !opts.Deduplicater(ctx, doc) {
+			filtered = append(filtered, doc)
+		}
+	}
+
+	return filtered
+}
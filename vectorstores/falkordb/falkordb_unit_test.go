@@ -0,0 +1,112 @@
+package falkordb
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEmbedder struct{}
+
+func (testEmbedder) EmbedDocuments(_ context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, len(texts))
+	for i, text := range texts {
+		vectors[i] = []float32{float32(len(text)), 1}
+	}
+	return vectors, nil
+}
+
+func (testEmbedder) EmbedQuery(_ context.Context, text string) ([]float32, error) {
+	return []float32{float32(len(text)), 1}, nil
+}
+
+func TestApplyClientOptions(t *testing.T) {
+	t.Parallel()
+
+	_, err := applyClientOptions(WithEmbedder(testEmbedder{}))
+	require.ErrorIs(t, err, ErrInvalidOptions)
+	assert.Contains(t, err.Error(), "missing falkordb connection")
+
+	_, err = applyClientOptions(WithConnectionURL("redis://localhost:6379"))
+	require.ErrorIs(t, err, ErrInvalidOptions)
+	assert.Contains(t, err.Error(), "missing embedder")
+
+	_, err = applyClientOptions(WithConnectionURL("redis://localhost:6379"), WithEmbedder(testEmbedder{}),
+		WithNodeLabel("Chunk) DELETE n //"))
+	require.ErrorIs(t, err, ErrInvalidOptions)
+
+	_, err = applyClientOptions(WithConnectionURL("redis://localhost:6379"), WithEmbedder(testEmbedder{}),
+		WithSimilarityFunction("dot"))
+	require.ErrorIs(t, err, ErrInvalidOptions)
+
+	s, err := applyClientOptions(WithConnectionURL("redis://localhost:6379"), WithEmbedder(testEmbedder{}))
+	require.NoError(t, err)
+	assert.Equal(t, defaultGraphName, s.graphName)
+	assert.Equal(t, defaultSimilarityFunction, s.similarityFunction)
+}
+
+func TestCypherLiteral(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in   any
+		want string
+	}{
+		{nil, "null"},
+		{"it's \"quoted\"\n", `"it's \"quoted\"\n"`},
+		{true, "true"},
+		{42, "42"},
+		{1.5, "1.5"},
+		{float32(2), "2.0"},
+		{[]float32{0.5, 1}, "[0.5,1.0]"},
+		{[]any{"a", 1}, `["a",1]`},
+		{map[string]any{"b": 1, "a": "x"}, `{a:"x",b:1}`},
+	}
+	for _, tt := range tests {
+		got, err := cypherLiteral(tt.in)
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, got)
+	}
+
+	_, err := cypherLiteral(struct{}{})
+	require.Error(t, err)
+	_, err = cypherLiteral(math.NaN())
+	require.Error(t, err)
+	_, err = cypherLiteral(map[string]any{"bad key": 1})
+	require.Error(t, err)
+}
+
+func TestParamsPrefix(t *testing.T) {
+	t.Parallel()
+
+	prefix, err := paramsPrefix(nil)
+	require.NoError(t, err)
+	assert.Empty(t, prefix)
+
+	prefix, err = paramsPrefix(map[string]any{"k": 3, "label": "Chunk"})
+	require.NoError(t, err)
+	assert.Equal(t, `CYPHER k=3 label="Chunk" `, prefix)
+
+	_, err = paramsPrefix(map[string]any{"k; DROP": 3})
+	require.Error(t, err)
+}
+
+func TestBuildSearchQuery(t *testing.T) {
+	t.Parallel()
+
+	s, err := applyClientOptions(WithConnectionURL("redis://localhost:6379"), WithEmbedder(testEmbedder{}))
+	require.NoError(t, err)
+
+	query, params := s.buildSearchQuery([]float32{1}, 2, "")
+	assert.NotContains(t, query, "WHERE")
+	assert.Equal(t, 2, params["limit"])
+
+	query, params = s.buildSearchQuery([]float32{1}, 2, "ns")
+	assert.Contains(t, query, "WHERE node.namespace = $namespace")
+	assert.Equal(t, 2*defaultFetchMultiplier, params["limit"])
+	assert.Equal(t, 2, params["k"])
+	assert.Equal(t, "ns", params["namespace"])
+}
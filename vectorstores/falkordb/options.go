@@ -0,0 +1,148 @@
+package falkordb
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/redis/rueidis"
+	"github.com/tmc/langchaingo/embeddings"
+)
+
+const (
+	defaultGraphName          = "langchain"
+	defaultNodeLabel          = "Chunk"
+	defaultTextProperty       = "text"
+	defaultEmbeddingProperty  = "embedding"
+	defaultSimilarityFunction = "cosine"
+	defaultFetchMultiplier    = 4
+)
+
+// ErrInvalidOptions is returned when the options given are invalid.
+var ErrInvalidOptions = errors.New("invalid options")
+
+// Option is a function type that can be used to modify the client.
+type Option func(p *Store)
+
+// WithEmbedder is an option for setting the embedder to use. Must be set.
+func WithEmbedder(e embeddings.Embedder) Option {
+	return func(p *Store) {
+		p.embedder = e
+	}
+}
+
+// WithConnectionURL is an option for specifying the Redis URL of the FalkorDB
+// instance, e.g. "redis://localhost:6379". Either this or WithClient must be used.
+func WithConnectionURL(connectionURL string) Option {
+	return func(p *Store) {
+		p.connURL = connectionURL
+	}
+}
+
+// WithClient is an option for specifying an existing rueidis client. The store
+// does not close clients passed in this way.
+func WithClient(client rueidis.Client) Option {
+	return func(p *Store) {
+		p.client = client
+	}
+}
+
+// WithGraphName is an option for specifying the graph that holds the
+// documents. Defaults to "langchain".
+func WithGraphName(name string) Option {
+	return func(p *Store) {
+		p.graphName = name
+	}
+}
+
+// WithNodeLabel is an option for specifying the label of document nodes.
+// Defaults to "Chunk".
+func WithNodeLabel(label string) Option {
+	return func(p *Store) {
+		p.nodeLabel = label
+	}
+}
+
+// WithTextProperty is an option for specifying the node property holding the
+// page content. Defaults to "text".
+func WithTextProperty(name string) Option {
+	return func(p *Store) {
+		p.textProperty = name
+	}
+}
+
+// WithEmbeddingProperty is an option for specifying the node property holding
+// the embedding. Defaults to "embedding".
+func WithEmbeddingProperty(name string) Option {
+	return func(p *Store) {
+		p.embeddingProperty = name
+	}
+}
+
+// WithVectorDimensions is an option for specifying the vector size. When set,
+// the vector index is created by New; otherwise it is created on the first
+// call to AddDocuments using the size of the returned embeddings.
+func WithVectorDimensions(size int) Option {
+	return func(p *Store) {
+		p.vectorDimensions = size
+	}
+}
+
+// WithSimilarityFunction is an option for specifying the similarity function of
+// the vector index, either "cosine" or "euclidean". Defaults to "cosine".
+func WithSimilarityFunction(name string) Option {
+	return func(p *Store) {
+		p.similarityFunction = name
+	}
+}
+
+// WithFetchMultiplier is an option for specifying how many more candidates than
+// requested are fetched from the index when a namespace is used, since it is
+// applied after the nearest-neighbor lookup. Defaults to 4.
+func WithFetchMultiplier(multiplier int) Option {
+	return func(p *Store) {
+		p.fetchMultiplier = multiplier
+	}
+}
+
+func applyClientOptions(opts ...Option) (Store, error) {
+	o := &Store{
+		graphName:          defaultGraphName,
+		nodeLabel:          defaultNodeLabel,
+		textProperty:       defaultTextProperty,
+		embeddingProperty:  defaultEmbeddingProperty,
+		similarityFunction: defaultSimilarityFunction,
+		fetchMultiplier:    defaultFetchMultiplier,
+	}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if o.client == nil && o.connURL == "" {
+		return Store{}, fmt.Errorf("%w: missing falkordb connection", ErrInvalidOptions)
+	}
+
+	if o.embedder == nil {
+		return Store{}, fmt.Errorf("%w: missing embedder", ErrInvalidOptions)
+	}
+
+	if o.graphName == "" {
+		return Store{}, fmt.Errorf("%w: missing graph name", ErrInvalidOptions)
+	}
+
+	for _, name := range []string{o.nodeLabel, o.textProperty, o.embeddingProperty} {
+		if !isIdentifier(name) {
+			return Store{}, fmt.Errorf("%w: invalid identifier %q", ErrInvalidOptions, name)
+		}
+	}
+
+	if o.similarityFunction != "cosine" && o.similarityFunction != "euclidean" {
+		return Store{}, fmt.Errorf("%w: unsupported similarity function %q", ErrInvalidOptions, o.similarityFunction)
+	}
+
+	if o.fetchMultiplier <= 0 || o.vectorDimensions < 0 {
+		return Store{}, fmt.Errorf("%w: fetch multiplier and dimensions must be positive", ErrInvalidOptions)
+	}
+
+	return *o, nil
+}
@@ -0,0 +1,195 @@
+package falkordb
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/redis/rueidis"
+)
+
+var identifierRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func isIdentifier(name string) bool {
+	return identifierRe.MatchString(name)
+}
+
+func quote(name string) string {
+	return "`" + name + "`"
+}
+
+// queryResult is a GRAPH.QUERY reply in its default (non-compact) form.
+type queryResult struct {
+	columns []string
+	rows    [][]rueidis.RedisMessage
+}
+
+// graphQuery runs a Cypher query against the store's graph. FalkorDB takes
+// parameters as a "CYPHER name=value ..." prefix, so params are rendered as
+// Cypher literals rather than sent separately.
+func (s Store) graphQuery(ctx context.Context, query string, params map[string]any) (queryResult, error) {
+	prefix, err := paramsPrefix(params)
+	if err != nil {
+		return queryResult{}, err
+	}
+
+	cmd := s.client.B().Arbitrary("GRAPH.QUERY").Keys(s.graphName).Args(prefix + query).Build()
+	reply, err := s.client.Do(ctx, cmd).ToArray()
+	if err != nil {
+		return queryResult{}, err
+	}
+	return parseQueryReply(reply)
+}
+
+// parseQueryReply handles both reply shapes: [stats] for queries without a
+// RETURN clause, and [header, rows, stats] otherwise.
+func parseQueryReply(reply []rueidis.RedisMessage) (queryResult, error) {
+	if len(reply) < 3 {
+		return queryResult{}, nil
+	}
+
+	header, err := reply[0].ToArray()
+	if err != nil {
+		return queryResult{}, fmt.Errorf("parsing result header: %w", err)
+	}
+	result := queryResult{columns: make([]string, len(header))}
+	for i := range header {
+		if result.columns[i], err = header[i].ToString(); err != nil {
+			return queryResult{}, fmt.Errorf("parsing result header: %w", err)
+		}
+	}
+
+	rows, err := reply[1].ToArray()
+	if err != nil {
+		return queryResult{}, fmt.Errorf("parsing result rows: %w", err)
+	}
+	for i := range rows {
+		row, err := rows[i].ToArray()
+		if err != nil {
+			return queryResult{}, fmt.Errorf("parsing result rows: %w", err)
+		}
+		result.rows = append(result.rows, row)
+	}
+	return result, nil
+}
+
+func (r queryResult) column(name string) int {
+	return slices.Index(r.columns, name)
+}
+
+func paramsPrefix(params map[string]any) (string, error) {
+	if len(params) == 0 {
+		return "", nil
+	}
+
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var b strings.Builder
+	b.WriteString("CYPHER ")
+	for _, name := range names {
+		if !isIdentifier(name) {
+			return "", fmt.Errorf("invalid parameter name %q", name)
+		}
+		literal, err := cypherLiteral(params[name])
+		if err != nil {
+			return "", fmt.Errorf("parameter %s: %w", name, err)
+		}
+		b.WriteString(name + "=" + literal + " ")
+	}
+	return b.String(), nil
+}
+
+// cypherLiteral renders v as a Cypher literal. Only the value types that can
+// be stored as FalkorDB properties are supported.
+//
+//nolint:cyclop
+func cypherLiteral(v any) (string, error) {
+	switch v := v.(type) {
+	case nil:
+		return "null", nil
+	case string:
+		return quoteString(v), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int:
+		return strconv.Itoa(v), nil
+	case int32:
+		return strconv.FormatInt(int64(v), 10), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case float32:
+		return formatFloat(float64(v))
+	case float64:
+		return formatFloat(v)
+	case []float32:
+		parts := make([]string, len(v))
+		for i, f := range v {
+			s, err := formatFloat(float64(f))
+			if err != nil {
+				return "", err
+			}
+			parts[i] = s
+		}
+		return "[" + strings.Join(parts, ",") + "]", nil
+	case []string:
+		parts := make([]string, len(v))
+		for i, s := range v {
+			parts[i] = quoteString(s)
+		}
+		return "[" + strings.Join(parts, ",") + "]", nil
+	case []any:
+		parts := make([]string, len(v))
+		for i, item := range v {
+			s, err := cypherLiteral(item)
+			if err != nil {
+				return "", err
+			}
+			parts[i] = s
+		}
+		return "[" + strings.Join(parts, ",") + "]", nil
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		parts := make([]string, len(keys))
+		for i, k := range keys {
+			if !isIdentifier(k) {
+				return "", fmt.Errorf("invalid map key %q", k)
+			}
+			s, err := cypherLiteral(v[k])
+			if err != nil {
+				return "", err
+			}
+			parts[i] = k + ":" + s
+		}
+		return "{" + strings.Join(parts, ",") + "}", nil
+	default:
+		return "", fmt.Errorf("unsupported parameter type %T", v)
+	}
+}
+
+func quoteString(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`)
+	return `"` + r.Replace(s) + `"`
+}
+
+func formatFloat(f float64) (string, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return "", fmt.Errorf("unsupported float value %v", f)
+	}
+	s := strconv.FormatFloat(f, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".eE") {
+		s += ".0"
+	}
+	return s, nil
+}
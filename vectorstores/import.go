@@ -0,0 +1,109 @@
+package vectorstores
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/tmc/langchaingo/schema"
+)
+
+// DefaultImportBatchSize is the number of documents ImportStream adds per
+// call to AddDocuments when no batch size is given.
+const DefaultImportBatchSize = 100
+
+// jsonDocument is the JSON Lines representation of a document read by
+// ImportStream, matching the field names used by LangChain's serializers.
+type jsonDocument struct {
+	PageContent string         `json:"page_content"`
+	Metadata    map[string]any `json:"metadata"`
+}
+
+// ImportStream reads documents from r as JSON Lines, one
+// {"page_content": ..., "metadata": {...}} object per line, and adds them to
+// store in batches of batchSize documents. At most one batch is held in
+// memory, so inputs of any size can be imported. A batchSize of zero or less
+// uses DefaultImportBatchSize. The options are passed to every AddDocuments
+// call. Blank lines and lines that are not a single JSON object are rejected.
+//
+// ImportStream returns the number of documents added. If it fails, every
+// document before that count has been added, so an import can be resumed by
+// skipping that many lines.
+func ImportStream(
+	ctx context.Context,
+	store VectorStore,
+	r io.Reader,
+	batchSize int,
+	options ...Option,
+) (int, error) {
+	if batchSize <= 0 {
+		batchSize = DefaultImportBatchSize
+	}
+
+	br := bufio.NewReader(r)
+	batch := make([]schema.Document, 0, batchSize)
+	imported := 0
+
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if _, err := store.AddDocuments(ctx, batch, options...); err != nil {
+			return fmt.Errorf("adding documents %d-%d: %w", imported+1, imported+len(batch), err)
+		}
+		imported += len(batch)
+		// Stores may keep the slice they were given, so don't reuse it.
+		batch = make([]schema.Document, 0, batchSize)
+		return nil
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return imported, err
+		}
+
+		line, err := br.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return imported, err
+		}
+		atEOF := err != nil
+		if atEOF && len(line) == 0 {
+			break
+		}
+
+		doc, err := decodeJSONLine(line)
+		if err != nil {
+			return imported, fmt.Errorf("decoding line %d: %w", imported+len(batch)+1, err)
+		}
+		batch = append(batch, doc)
+		if len(batch) == batchSize {
+			if err := flush(); err != nil {
+				return imported, err
+			}
+		}
+		if atEOF {
+			break
+		}
+	}
+	return imported, flush()
+}
+
+// errNotJSONObject is returned for a JSON Lines line that does not hold a
+// JSON object.
+var errNotJSONObject = errors.New("line is not a JSON object")
+
+func decodeJSONLine(line []byte) (schema.Document, error) {
+	line = bytes.TrimSpace(line)
+	if len(line) == 0 || line[0] != '{' {
+		return schema.Document{}, errNotJSONObject
+	}
+	var doc jsonDocument
+	if err := json.Unmarshal(line, &doc); err != nil {
+		return schema.Document{}, err
+	}
+	return schema.Document{PageContent: doc.PageContent, Metadata: doc.Metadata}, nil
+}
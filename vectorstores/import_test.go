@@ -0,0 +1,91 @@
+package vectorstores_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tmc/langchaingo/schema"
+	"github.com/tmc/langchaingo/vectorstores"
+)
+
+// batchStore keeps the slices it is given, like a store that queues writes.
+type batchStore struct {
+	batches [][]schema.Document
+	err     error
+}
+
+func (s *batchStore) AddDocuments(_ context.Context, docs []schema.Document, _ ...vectorstores.Option) ([]string, error) {
+	if s.err != nil && len(s.batches) > 0 {
+		return nil, s.err
+	}
+	s.batches = append(s.batches, docs)
+	return make([]string, len(docs)), nil
+}
+
+func (s *batchStore) SimilaritySearch(_ context.Context, _ string, _ int, _ ...vectorstores.Option) ([]schema.Document, error) {
+	return nil, nil
+}
+
+const testJSONL = `{"page_content": "tokyo", "metadata": {"country": "japan"}}
+{"page_content": "paris", "metadata": {"country": "france"}}
+{"page_content": "potato"}
+`
+
+func TestImportStream(t *testing.T) {
+	t.Parallel()
+
+	store := &batchStore{}
+	n, err := vectorstores.ImportStream(context.Background(), store, strings.NewReader(testJSONL), 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	require.Len(t, store.batches, 2)
+	assert.Len(t, store.batches[0], 2)
+	assert.Equal(t, "tokyo", store.batches[0][0].PageContent)
+	assert.Equal(t, "japan", store.batches[0][0].Metadata["country"])
+	assert.Equal(t, []schema.Document{{PageContent: "potato"}}, store.batches[1])
+
+	// Batches are not overwritten by later ones, and a final line without a
+	// trailing newline is still imported.
+	store = &batchStore{}
+	n, err = vectorstores.ImportStream(context.Background(), store,
+		strings.NewReader(strings.TrimSuffix(testJSONL, "\n")), 1)
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+	require.Len(t, store.batches, 3)
+	assert.Equal(t, "tokyo", store.batches[0][0].PageContent)
+	assert.Equal(t, "paris", store.batches[1][0].PageContent)
+}
+
+func TestImportStreamErrors(t *testing.T) {
+	t.Parallel()
+
+	store := &batchStore{}
+	n, err := vectorstores.ImportStream(context.Background(), store,
+		strings.NewReader(testJSONL+"{not json}\n"), 0)
+	require.ErrorContains(t, err, "decoding line 4")
+	assert.Equal(t, 0, n)
+	assert.Empty(t, store.batches)
+
+	for _, input := range []string{
+		"{\"page_content\":\n\"tokyo\"}\n",
+		"{\"page_content\": \"a\"} {\"page_content\": \"b\"}\n",
+		"null\n",
+		"\n",
+	} {
+		store = &batchStore{}
+		_, err = vectorstores.ImportStream(context.Background(), store, strings.NewReader(input), 0)
+		require.ErrorContains(t, err, "decoding line 1", "input %q", input)
+		assert.Empty(t, store.batches)
+	}
+
+	errStore := errors.New("store unavailable")
+	store = &batchStore{err: errStore}
+	n, err = vectorstores.ImportStream(context.Background(), store, strings.NewReader(testJSONL), 2)
+	require.ErrorIs(t, err, errStore)
+	assert.Equal(t, 2, n)
+}
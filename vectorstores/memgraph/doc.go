@@ -0,0 +1,7 @@
+// Package memgraph contains an implementation of the VectorStore
+// interface using Memgraph and its built-in vector index.
+//
+// Documents are stored as nodes carrying the page content, the metadata
+// map and the embedding. Similarity search is served by the
+// vector_search.search procedure, so Memgraph 2.22 or newer is required.
+package memgraph
@@ -0,0 +1,360 @@
+package memgraph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/tmc/langchaingo/embeddings"
+	"github.com/tmc/langchaingo/schema"
+	"github.com/tmc/langchaingo/vectorstores"
+)
+
+var (
+	ErrEmbedderWrongNumberVectors = errors.New("number of vectors from embedder does not match number of documents")
+	ErrInvalidScoreThreshold      = errors.New("score threshold must be between 0 and 1")
+	ErrInvalidFilters             = errors.New("invalid filters")
+)
+
+const (
+	metadataProperty  = "metadata"
+	namespaceProperty = "namespace"
+)
+
+var identifierRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// Store is a wrapper around the Memgraph driver.
+type Store struct {
+	embedder          embeddings.Embedder
+	driver            neo4j.DriverWithContext
+	ownsDriver        bool
+	connURL           string
+	username          string
+	password          string
+	indexName         string
+	nodeLabel         string
+	textProperty      string
+	embeddingProperty string
+	metric            string
+	capacity          int
+	vectorDimensions  int
+	fetchMultiplier   int
+}
+
+var _ vectorstores.VectorStore = Store{}
+
+// New creates a new Store with options. If the vector dimensions are known the
+// vector index is created up front.
+func New(ctx context.Context, opts ...Option) (Store, error) {
+	store, err := applyClientOptions(opts...)
+	if err != nil {
+		return Store{}, err
+	}
+	if store.driver == nil {
+		auth := neo4j.NoAuth()
+		if store.username != "" {
+			auth = neo4j.BasicAuth(store.username, store.password, "")
+		}
+		store.driver, err = neo4j.NewDriverWithContext(store.connURL, auth)
+		if err != nil {
+			return Store{}, err
+		}
+		store.ownsDriver = true
+	}
+	if err := store.driver.VerifyConnectivity(ctx); err != nil {
+		return Store{}, err
+	}
+	if store.vectorDimensions > 0 {
+		if err := store.ensureIndex(ctx, store.vectorDimensions); err != nil {
+			return Store{}, err
+		}
+	}
+	return store, nil
+}
+
+// Close closes the driver if it was created by the store.
+func (s Store) Close(ctx context.Context) error {
+	if !s.ownsDriver {
+		return nil
+	}
+	return s.driver.Close(ctx)
+}
+
+// AddDocuments adds documents as nodes to Memgraph and returns the ids of the
+// added documents. The namespace option, if set, is stored on every node and
+// can be used to scope later searches.
+func (s Store) AddDocuments(
+	ctx context.Context,
+	docs []schema.Document,
+	options ...vectorstores.Option,
+) ([]string, error) {
+	opts := s.getOptions(options...)
+
+	docs = s.deduplicate(ctx, opts, docs)
+	if len(docs) == 0 {
+		return []string{}, nil
+	}
+
+	texts := make([]string, 0, len(docs))
+	for _, doc := range docs {
+		texts = append(texts, doc.PageContent)
+	}
+
+	vectors, err := s.getEmbedder(opts).EmbedDocuments(ctx, texts)
+	if err != nil {
+		return nil, err
+	}
+	if len(vectors) != len(docs) {
+		return nil, ErrEmbedderWrongNumberVectors
+	}
+
+	dims := s.vectorDimensions
+	if dims == 0 {
+		dims = len(vectors[0])
+	}
+	if err := s.ensureIndex(ctx, dims); err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(docs))
+	rows := make([]any, 0, len(docs))
+	for i, doc := range docs {
+		ids[i] = uuid.New().String()
+		row := map[string]any{
+			"id":        ids[i],
+			"text":      doc.PageContent,
+			"metadata":  doc.Metadata,
+			"embedding": toFloat64s(vectors[i]),
+		}
+		if opts.NameSpace != "" {
+			row["namespace"] = opts.NameSpace
+		}
+		rows = append(rows, row)
+	}
+
+	query := fmt.Sprintf(`UNWIND $rows AS row
+CREATE (n:%s {id: row.id})
+SET n.%s = row.text, n.%s = row.metadata, n.%s = row.namespace, n.%s = row.embedding`,
+		quote(s.nodeLabel), quote(s.textProperty), metadataProperty, namespaceProperty, quote(s.embeddingProperty))
+
+	if _, err := s.run(ctx, query, map[string]any{"rows": rows}); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// SimilaritySearch performs a vector similarity search. Filters are given as a
+// map[string]any and match metadata values by equality.
+func (s Store) SimilaritySearch(
+	ctx context.Context,
+	query string,
+	numDocuments int,
+	options ...vectorstores.Option,
+) ([]schema.Document, error) {
+	opts := s.getOptions(options...)
+	scoreThreshold, err := s.getScoreThreshold(opts)
+	if err != nil {
+		return nil, err
+	}
+	filters, err := s.getFilters(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	vector, err := s.getEmbedder(opts).EmbedQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	cypher, params := s.buildSearchQuery(vector, numDocuments, opts.NameSpace, scoreThreshold, filters)
+	records, err := s.run(ctx, cypher, params)
+	if err != nil {
+		return nil, err
+	}
+
+	docs := make([]schema.Document, 0, len(records))
+	for _, record := range records {
+		doc := schema.Document{}
+		if text, ok := record.Get("text"); ok {
+			doc.PageContent, _ = text.(string)
+		}
+		if metadata, ok := record.Get("metadata"); ok {
+			doc.Metadata, _ = metadata.(map[string]any)
+		}
+		if similarity, ok := record.Get("similarity"); ok {
+			if f, ok := similarity.(float64); ok {
+				doc.Score = float32(f)
+			}
+		}
+		docs = append(docs, doc)
+	}
+	return docs, nil
+}
+
+func (s Store) buildSearchQuery(
+	vector []float32,
+	numDocuments int,
+	namespace string,
+	scoreThreshold float32,
+	filters map[string]any,
+) (string, map[string]any) {
+	params := map[string]any{
+		"index":  s.indexName,
+		"vector": toFloat64s(vector),
+		"k":      numDocuments,
+	}
+
+	conditions := make([]string, 0, len(filters)+2)
+	if namespace != "" {
+		conditions = append(conditions, "node."+namespaceProperty+" = $namespace")
+		params["namespace"] = namespace
+	}
+	if scoreThreshold != 0 {
+		conditions = append(conditions, "similarity >= $threshold")
+		params["threshold"] = float64(scoreThreshold)
+	}
+	keys := make([]string, 0, len(filters))
+	for k := range filters {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	for i, k := range keys {
+		name := fmt.Sprintf("filter%d", i)
+		conditions = append(conditions, fmt.Sprintf("node.%s.%s = $%s", metadataProperty, quote(k), name))
+		params[name] = filters[k]
+	}
+
+	limit := numDocuments
+	if len(conditions) > 0 {
+		limit = numDocuments * s.fetchMultiplier
+	}
+	params["limit"] = limit
+
+	var b strings.Builder
+	b.WriteString("CALL vector_search.search($index, $limit, $vector) YIELD node, similarity\n")
+	b.WriteString("WITH node, similarity\n")
+	if len(conditions) > 0 {
+		b.WriteString("WHERE " + strings.Join(conditions, " AND ") + "\n")
+	}
+	fmt.Fprintf(&b, "RETURN node.%s AS text, node.%s AS metadata, similarity\n", quote(s.textProperty), metadataProperty)
+	b.WriteString("ORDER BY similarity DESC\nLIMIT $k")
+	return b.String(), params
+}
+
+// DropIndex removes the vector index and all document nodes with the
+// configured label.
+func (s Store) DropIndex(ctx context.Context) error {
+	if _, err := s.run(ctx, fmt.Sprintf("DROP VECTOR INDEX %s", s.indexName), nil); err != nil {
+		return err
+	}
+	_, err := s.run(ctx, fmt.Sprintf("MATCH (n:%s) DETACH DELETE n", quote(s.nodeLabel)), nil)
+	return err
+}
+
+func (s Store) ensureIndex(ctx context.Context, dims int) error {
+	records, err := s.run(ctx, "CALL vector_search.show_index_info() YIELD index_name RETURN index_name", nil)
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		if name, ok := record.Get("index_name"); ok && name == s.indexName {
+			return nil
+		}
+	}
+
+	// Index DDL has to run in an implicit transaction in Memgraph, which is
+	// what run uses, and identifiers cannot be passed as parameters.
+	query := fmt.Sprintf(`CREATE VECTOR INDEX %s ON :%s(%s) WITH CONFIG {"dimension": %d, "capacity": %d, "metric": "%s"}`,
+		s.indexName, quote(s.nodeLabel), quote(s.embeddingProperty), dims, s.capacity, s.metric)
+	_, err = s.run(ctx, query, nil)
+	return err
+}
+
+func (s Store) run(ctx context.Context, query string, params map[string]any) ([]*neo4j.Record, error) {
+	session := s.driver.NewSession(ctx, neo4j.SessionConfig{})
+	defer session.Close(ctx)
+
+	result, err := session.Run(ctx, query, params)
+	if err != nil {
+		return nil, err
+	}
+	return result.Collect(ctx)
+}
+
+func (s Store) getOptions(options ...vectorstores.Option) vectorstores.Options {
+	opts := vectorstores.Options{}
+	for _, opt := range options {
+		opt(&opts)
+	}
+	return opts
+}
+
+func (s Store) getEmbedder(opts vectorstores.Options) embeddings.Embedder {
+	if opts.Embedder != nil {
+		return opts.Embedder
+	}
+	return s.embedder
+}
+
+func (s Store) getScoreThreshold(opts vectorstores.Options) (float32, error) {
+	if opts.ScoreThreshold < 0 || opts.ScoreThreshold > 1 {
+		return 0, ErrInvalidScoreThreshold
+	}
+	return opts.ScoreThreshold, nil
+}
+
+func (s Store) getFilters(opts vectorstores.Options) (map[string]any, error) {
+	if opts.Filters == nil {
+		return nil, nil
+	}
+	filters, ok := opts.Filters.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected map[string]any, got %T", ErrInvalidFilters, opts.Filters)
+	}
+	for k := range filters {
+		if !isIdentifier(k) {
+			return nil, fmt.Errorf("%w: invalid metadata key %q", ErrInvalidFilters, k)
+		}
+	}
+	return filters, nil
+}
+
+func (s Store) deduplicate(
+	ctx context.Context,
+	opts vectorstores.Options,
+	docs []schema.Document,
+) []schema.Document {
+	if opts.Deduplicater == nil {
+		return docs
+	}
+
+	filtered := make([]schema.Document, 0, len(docs))
+	for _, doc := range docs {
+		if !opts.Deduplicater(ctx, doc) {
+			filtered = append(filtered, doc)
+		}
+	}
+
+	return filtered
+}
+
+func isIdentifier(name string) bool {
+	return identifierRe.MatchString(name)
+}
+
+func quote(name string) string {
+	return "`" + name + "`"
+}
+
+func toFloat64s(v []float32) []float64 {
+	out := make([]float64, len(v))
+	for i, f := range v {
+		out[i] = float64(f)
+	}
+	return out
+}
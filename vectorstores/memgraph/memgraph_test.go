@@ -0,0 +1,129 @@
+package memgraph_test
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	tclog "github.com/testcontainers/testcontainers-go/log"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"github.com/tmc/langchaingo/internal/testutil/testctr"
+	"github.com/tmc/langchaingo/schema"
+	"github.com/tmc/langchaingo/vectorstores"
+	"github.com/tmc/langchaingo/vectorstores/memgraph"
+)
+
+// keywordEmbedder maps texts onto a small fixed vocabulary so results are
+// deterministic without calling an embedding API.
+type keywordEmbedder struct{}
+
+var vocabulary = []string{"tokyo", "japan", "potato", "paris", "france"}
+
+func (keywordEmbedder) EmbedDocuments(ctx context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, len(texts))
+	for i, text := range texts {
+		vectors[i], _ = keywordEmbedder{}.EmbedQuery(ctx, text)
+	}
+	return vectors, nil
+}
+
+func (keywordEmbedder) EmbedQuery(_ context.Context, text string) ([]float32, error) {
+	vector := make([]float32, len(vocabulary)+1)
+	vector[len(vocabulary)] = 0.01
+	for i, word := range vocabulary {
+		if strings.Contains(strings.ToLower(text), word) {
+			vector[i] = 1
+		}
+	}
+	return vector, nil
+}
+
+func getMemgraphURL(t *testing.T) string {
+	t.Helper()
+	testctr.SkipIfDockerNotAvailable(t)
+
+	if testing.Short() {
+		t.Skip("Skipping test in short mode")
+	}
+
+	if uri := os.Getenv("MEMGRAPH_URL"); uri != "" {
+		return uri
+	}
+
+	ctx := context.Background()
+	container, err := testcontainers.Run(ctx,
+		"memgraph/memgraph:3.2.1",
+		testcontainers.WithExposedPorts("7687/tcp"),
+		testcontainers.WithWaitStrategy(wait.ForListeningPort("7687/tcp")),
+		testcontainers.WithLogger(tclog.TestLogger(t)),
+	)
+	if err != nil && strings.Contains(err.Error(), "Cannot connect to the Docker daemon") {
+		t.Skip("Docker not available")
+	}
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := container.Terminate(context.Background()); err != nil {
+			t.Logf("Failed to terminate memgraph container: %v", err)
+		}
+	})
+
+	endpoint, err := container.PortEndpoint(ctx, "7687/tcp", "bolt")
+	require.NoError(t, err)
+	return endpoint
+}
+
+func TestMemgraphStore(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	store, err := memgraph.New(ctx,
+		memgraph.WithConnectionURL(getMemgraphURL(t)),
+		memgraph.WithEmbedder(keywordEmbedder{}),
+		memgraph.WithIndexName("test_index"),
+		memgraph.WithNodeLabel("TestChunk"),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, store.DropIndex(ctx))
+		require.NoError(t, store.Close(ctx))
+	})
+
+	ids, err := store.AddDocuments(ctx, []schema.Document{
+		{PageContent: "tokyo is in japan", Metadata: map[string]any{"country": "japan"}},
+		{PageContent: "paris is in france", Metadata: map[string]any{"country": "france"}},
+		{PageContent: "potato"},
+	})
+	require.NoError(t, err)
+	require.Len(t, ids, 3)
+
+	_, err = store.AddDocuments(ctx, []schema.Document{
+		{PageContent: "tokyo tower", Metadata: map[string]any{"country": "japan"}},
+	}, vectorstores.WithNameSpace("landmarks"))
+	require.NoError(t, err)
+
+	docs, err := store.SimilaritySearch(ctx, "japan", 1)
+	require.NoError(t, err)
+	require.Len(t, docs, 1)
+	assert.Equal(t, "tokyo is in japan", docs[0].PageContent)
+	assert.Equal(t, "japan", docs[0].Metadata["country"])
+
+	docs, err = store.SimilaritySearch(ctx, "city", 5,
+		vectorstores.WithFilters(map[string]any{"country": "france"}))
+	require.NoError(t, err)
+	require.Len(t, docs, 1)
+	assert.Equal(t, "paris is in france", docs[0].PageContent)
+
+	docs, err = store.SimilaritySearch(ctx, "tokyo", 5, vectorstores.WithNameSpace("landmarks"))
+	require.NoError(t, err)
+	require.Len(t, docs, 1)
+	assert.Equal(t, "tokyo tower", docs[0].PageContent)
+
+	docs, err = store.SimilaritySearch(ctx, "potato", 5, vectorstores.WithScoreThreshold(0.9))
+	require.NoError(t, err)
+	require.Len(t, docs, 1)
+	assert.Equal(t, "potato", docs[0].PageContent)
+}
@@ -0,0 +1,118 @@
+package memgraph
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tmc/langchaingo/vectorstores"
+)
+
+type testEmbedder struct{}
+
+func (testEmbedder) EmbedDocuments(_ context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, len(texts))
+	for i, text := range texts {
+		vectors[i] = []float32{float32(len(text)), 1, 0}
+	}
+	return vectors, nil
+}
+
+func (testEmbedder) EmbedQuery(_ context.Context, text string) ([]float32, error) {
+	return []float32{float32(len(text)), 1, 0}, nil
+}
+
+func TestApplyClientOptions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		opts        []Option
+		errContains string
+	}{
+		{
+			name: "defaults",
+			opts: []Option{WithConnectionURL("bolt://localhost:7687"), WithEmbedder(testEmbedder{})},
+		},
+		{
+			name:        "missing connection",
+			opts:        []Option{WithEmbedder(testEmbedder{})},
+			errContains: "missing memgraph connection",
+		},
+		{
+			name:        "missing embedder",
+			opts:        []Option{WithConnectionURL("bolt://localhost:7687")},
+			errContains: "missing embedder",
+		},
+		{
+			name: "invalid label",
+			opts: []Option{
+				WithConnectionURL("bolt://localhost:7687"),
+				WithEmbedder(testEmbedder{}),
+				WithNodeLabel("Chunk`) DETACH DELETE n //"),
+			},
+			errContains: "invalid identifier",
+		},
+		{
+			name: "unsupported metric",
+			opts: []Option{
+				WithConnectionURL("bolt://localhost:7687"),
+				WithEmbedder(testEmbedder{}),
+				WithMetric("hamming"),
+			},
+			errContains: "unsupported metric",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s, err := applyClientOptions(tt.opts...)
+			if tt.errContains != "" {
+				require.ErrorIs(t, err, ErrInvalidOptions)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, defaultIndexName, s.indexName)
+			assert.Equal(t, defaultNodeLabel, s.nodeLabel)
+			assert.Equal(t, defaultMetric, s.metric)
+		})
+	}
+}
+
+func TestBuildSearchQuery(t *testing.T) {
+	t.Parallel()
+
+	s, err := applyClientOptions(WithConnectionURL("bolt://localhost:7687"), WithEmbedder(testEmbedder{}))
+	require.NoError(t, err)
+
+	query, params := s.buildSearchQuery([]float32{1, 2}, 3, "", 0, nil)
+	assert.NotContains(t, query, "WHERE")
+	assert.Equal(t, 3, params["limit"])
+	assert.Equal(t, []float64{1, 2}, params["vector"])
+
+	query, params = s.buildSearchQuery([]float32{1, 2}, 3, "ns", 0.5, map[string]any{"b": 2, "a": "x"})
+	assert.Contains(t, query, "WHERE node.namespace = $namespace AND similarity >= $threshold"+
+		" AND node.metadata.`a` = $filter0 AND node.metadata.`b` = $filter1")
+	assert.Equal(t, 3*defaultFetchMultiplier, params["limit"])
+	assert.Equal(t, 3, params["k"])
+	assert.Equal(t, "x", params["filter0"])
+	assert.Equal(t, 2, params["filter1"])
+}
+
+func TestGetFilters(t *testing.T) {
+	t.Parallel()
+
+	s := Store{}
+	_, err := s.getFilters(vectorstores.Options{Filters: "country = 'japan'"})
+	require.ErrorIs(t, err, ErrInvalidFilters)
+
+	_, err = s.getFilters(vectorstores.Options{Filters: map[string]any{"a.b": 1}})
+	require.ErrorIs(t, err, ErrInvalidFilters)
+
+	filters, err := s.getFilters(vectorstores.Options{Filters: map[string]any{"country": "japan"}})
+	require.NoError(t, err)
+	assert.Equal(t, "japan", filters["country"])
+}
@@ -0,0 +1,165 @@
+package memgraph
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/tmc/langchaingo/embeddings"
+)
+
+const (
+	defaultIndexName         = "langchain_index"
+	defaultNodeLabel         = "Chunk"
+	defaultTextProperty      = "text"
+	defaultEmbeddingProperty = "embedding"
+	defaultMetric            = "cos"
+	defaultCapacity          = 1000
+	defaultFetchMultiplier   = 4
+)
+
+// ErrInvalidOptions is returned when the options given are invalid.
+var ErrInvalidOptions = errors.New("invalid options")
+
+// Option is a function type that can be used to modify the client.
+type Option func(p *Store)
+
+// WithEmbedder is an option for setting the embedder to use. Must be set.
+func WithEmbedder(e embeddings.Embedder) Option {
+	return func(p *Store) {
+		p.embedder = e
+	}
+}
+
+// WithConnectionURL is an option for specifying the Bolt URL of the Memgraph
+// instance, e.g. "bolt://localhost:7687". Either this or WithDriver must be used.
+func WithConnectionURL(connectionURL string) Option {
+	return func(p *Store) {
+		p.connURL = connectionURL
+	}
+}
+
+// WithCredentials is an option for specifying the username and password used
+// when connecting with WithConnectionURL.
+func WithCredentials(username, password string) Option {
+	return func(p *Store) {
+		p.username = username
+		p.password = password
+	}
+}
+
+// WithDriver is an option for specifying an existing driver. The store does not
+// close drivers passed in this way.
+func WithDriver(driver neo4j.DriverWithContext) Option {
+	return func(p *Store) {
+		p.driver = driver
+	}
+}
+
+// WithIndexName is an option for specifying the vector index name.
+// Defaults to "langchain_index".
+func WithIndexName(name string) Option {
+	return func(p *Store) {
+		p.indexName = name
+	}
+}
+
+// WithNodeLabel is an option for specifying the label of document nodes.
+// Defaults to "Chunk".
+func WithNodeLabel(label string) Option {
+	return func(p *Store) {
+		p.nodeLabel = label
+	}
+}
+
+// WithTextProperty is an option for specifying the node property holding the
+// page content. Defaults to "text".
+func WithTextProperty(name string) Option {
+	return func(p *Store) {
+		p.textProperty = name
+	}
+}
+
+// WithEmbeddingProperty is an option for specifying the node property holding
+// the embedding. Defaults to "embedding".
+func WithEmbeddingProperty(name string) Option {
+	return func(p *Store) {
+		p.embeddingProperty = name
+	}
+}
+
+// WithVectorDimensions is an option for specifying the vector size. When set,
+// the vector index is created by New; otherwise it is created on the first
+// call to AddDocuments using the size of the returned embeddings.
+func WithVectorDimensions(size int) Option {
+	return func(p *Store) {
+		p.vectorDimensions = size
+	}
+}
+
+// WithMetric is an option for specifying the similarity metric of the vector
+// index, one of "cos", "l2sq" or "ip". Defaults to "cos".
+func WithMetric(metric string) Option {
+	return func(p *Store) {
+		p.metric = metric
+	}
+}
+
+// WithCapacity is an option for specifying the initial capacity of the vector
+// index. Defaults to 1000.
+func WithCapacity(capacity int) Option {
+	return func(p *Store) {
+		p.capacity = capacity
+	}
+}
+
+// WithFetchMultiplier is an option for specifying how many more candidates than
+// requested are fetched from the index when filters or a namespace are used,
+// since those are applied after the nearest-neighbor lookup. Defaults to 4.
+func WithFetchMultiplier(multiplier int) Option {
+	return func(p *Store) {
+		p.fetchMultiplier = multiplier
+	}
+}
+
+func applyClientOptions(opts ...Option) (Store, error) {
+	o := &Store{
+		indexName:         defaultIndexName,
+		nodeLabel:         defaultNodeLabel,
+		textProperty:      defaultTextProperty,
+		embeddingProperty: defaultEmbeddingProperty,
+		metric:            defaultMetric,
+		capacity:          defaultCapacity,
+		fetchMultiplier:   defaultFetchMultiplier,
+	}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if o.driver == nil && o.connURL == "" {
+		return Store{}, fmt.Errorf("%w: missing memgraph connection", ErrInvalidOptions)
+	}
+
+	if o.embedder == nil {
+		return Store{}, fmt.Errorf("%w: missing embedder", ErrInvalidOptions)
+	}
+
+	for _, name := range []string{o.indexName, o.nodeLabel, o.textProperty, o.embeddingProperty} {
+		if !isIdentifier(name) {
+			return Store{}, fmt.Errorf("%w: invalid identifier %q", ErrInvalidOptions, name)
+		}
+	}
+
+	switch o.metric {
+	case "cos", "l2sq", "ip":
+	default:
+		return Store{}, fmt.Errorf("%w: unsupported metric %q", ErrInvalidOptions, o.metric)
+	}
+
+	if o.capacity <= 0 || o.fetchMultiplier <= 0 || o.vectorDimensions < 0 {
+		return Store{}, fmt.Errorf("%w: capacity, fetch multiplier and dimensions must be positive", ErrInvalidOptions)
+	}
+
+	return *o, nil
+}
@@ -0,0 +1,11 @@
+// Package metrics provides Prometheus instrumentation for vector stores.
+//
+// A Collector owns a set of operation counters, latency histograms and
+// in-flight gauges labeled by store name. Any vectorstores.VectorStore can be
+// wrapped with Collector.Wrap to report into it, so every backend produces the
+// same series and dashboards do not depend on the backend in use:
+//
+//	collector := metrics.NewCollector()
+//	prometheus.MustRegister(collector)
+//	store := collector.Wrap(pgvectorStore, "pgvector")
+package metrics
@@ -0,0 +1,138 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/tmc/langchaingo/schema"
+	"github.com/tmc/langchaingo/vectorstores"
+)
+
+const (
+	operationAddDocuments     = "add_documents"
+	operationSimilaritySearch = "similarity_search"
+
+	statusSuccess = "success"
+	statusError   = "error"
+)
+
+// Collector holds the metrics shared by all stores wrapped with it. It
+// implements prometheus.Collector and must be registered to be exported.
+type Collector struct {
+	operations *prometheus.CounterVec
+	latency    *prometheus.HistogramVec
+	inFlight   *prometheus.GaugeVec
+	documents  *prometheus.CounterVec
+}
+
+var _ prometheus.Collector = (*Collector)(nil)
+
+// NewCollector creates a Collector.
+func NewCollector(opts ...Option) *Collector {
+	o := options{
+		namespace: defaultNamespace,
+		buckets:   prometheus.DefBuckets,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return &Collector{
+		operations: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   o.namespace,
+			Subsystem:   "vectorstore",
+			Name:        "operations_total",
+			Help:        "Number of vector store operations by store, operation and status.",
+			ConstLabels: o.constLabels,
+		}, []string{"store", "operation", "status"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace:   o.namespace,
+			Subsystem:   "vectorstore",
+			Name:        "operation_duration_seconds",
+			Help:        "Latency of vector store operations by store and operation.",
+			Buckets:     o.buckets,
+			ConstLabels: o.constLabels,
+		}, []string{"store", "operation"}),
+		inFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace:   o.namespace,
+			Subsystem:   "vectorstore",
+			Name:        "in_flight_operations",
+			Help:        "Number of vector store operations currently in progress by store.",
+			ConstLabels: o.constLabels,
+		}, []string{"store"}),
+		documents: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   o.namespace,
+			Subsystem:   "vectorstore",
+			Name:        "documents_total",
+			Help:        "Number of documents added or returned by store and operation.",
+			ConstLabels: o.constLabels,
+		}, []string{"store", "operation"}),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	c.operations.Describe(ch)
+	c.latency.Describe(ch)
+	c.inFlight.Describe(ch)
+	c.documents.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.operations.Collect(ch)
+	c.latency.Collect(ch)
+	c.inFlight.Collect(ch)
+	c.documents.Collect(ch)
+}
+
+// Wrap returns a VectorStore that records metrics for store under the given
+// store label, e.g. "pgvector" or "qdrant".
+func (c *Collector) Wrap(store vectorstores.VectorStore, name string) *Store {
+	return &Store{store: store, name: name, collector: c}
+}
+
+// observe starts tracking an operation and returns a function that finishes
+// it with the result of the operation.
+func (c *Collector) observe(name, operation string) func(documents int, err error) {
+	start := time.Now()
+	inFlight := c.inFlight.WithLabelValues(name)
+	inFlight.Inc()
+
+	return func(documents int, err error) {
+		inFlight.Dec()
+		c.latency.WithLabelValues(name, operation).Observe(time.Since(start).Seconds())
+		status := statusSuccess
+		if err != nil {
+			status = statusError
+		}
+		c.operations.WithLabelValues(name, operation, status).Inc()
+		c.documents.WithLabelValues(name, operation).Add(float64(documents))
+	}
+}
+
+// Store is a VectorStore wrapper that records metrics into a Collector.
+type Store struct {
+	store     vectorstores.VectorStore
+	name      string
+	collector *Collector
+}
+
+var _ vectorstores.VectorStore = (*Store)(nil)
+
+// AddDocuments adds documents to the wrapped store.
+func (s *Store) AddDocuments(ctx context.Context, docs []schema.Document, options ...vectorstores.Option) ([]string, error) {
+	done := s.collector.observe(s.name, operationAddDocuments)
+	ids, err := s.store.AddDocuments(ctx, docs, options...)
+	done(len(ids), err)
+	return ids, err
+}
+
+// SimilaritySearch searches the wrapped store.
+func (s *Store) SimilaritySearch(ctx context.Context, query string, numDocuments int, options ...vectorstores.Option) ([]schema.Document, error) { //nolint:lll
+	done := s.collector.observe(s.name, operationSimilaritySearch)
+	docs, err := s.store.SimilaritySearch(ctx, query, numDocuments, options...)
+	done(len(docs), err)
+	return docs, err
+}
@@ -0,0 +1,81 @@
+package metrics_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tmc/langchaingo/schema"
+	"github.com/tmc/langchaingo/vectorstores"
+	"github.com/tmc/langchaingo/vectorstores/metrics"
+)
+
+type testStore struct {
+	err error
+}
+
+func (s testStore) AddDocuments(_ context.Context, docs []schema.Document, _ ...vectorstores.Option) ([]string, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	ids := make([]string, len(docs))
+	for i := range docs {
+		ids[i] = docs[i].PageContent
+	}
+	return ids, nil
+}
+
+func (s testStore) SimilaritySearch(_ context.Context, query string, _ int, _ ...vectorstores.Option) ([]schema.Document, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return []schema.Document{{PageContent: query}}, nil
+}
+
+func TestCollector(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	collector := metrics.NewCollector(metrics.WithConstLabels(prometheus.Labels{"service": "test"}))
+	registry := prometheus.NewPedanticRegistry()
+	require.NoError(t, registry.Register(collector))
+
+	good := collector.Wrap(testStore{}, "good")
+	bad := collector.Wrap(testStore{err: errors.New("boom")}, "bad")
+
+	_, err := good.AddDocuments(ctx, []schema.Document{{PageContent: "a"}, {PageContent: "b"}})
+	require.NoError(t, err)
+	_, err = good.SimilaritySearch(ctx, "a", 1)
+	require.NoError(t, err)
+	_, err = bad.SimilaritySearch(ctx, "a", 1)
+	require.Error(t, err)
+
+	expected := `
+# HELP langchaingo_vectorstore_operations_total Number of vector store operations by store, operation and status.
+# TYPE langchaingo_vectorstore_operations_total counter
+langchaingo_vectorstore_operations_total{operation="add_documents",service="test",status="success",store="good"} 1
+langchaingo_vectorstore_operations_total{operation="similarity_search",service="test",status="error",store="bad"} 1
+langchaingo_vectorstore_operations_total{operation="similarity_search",service="test",status="success",store="good"} 1
+# HELP langchaingo_vectorstore_documents_total Number of documents added or returned by store and operation.
+# TYPE langchaingo_vectorstore_documents_total counter
+langchaingo_vectorstore_documents_total{operation="add_documents",service="test",store="good"} 2
+langchaingo_vectorstore_documents_total{operation="similarity_search",service="test",store="bad"} 0
+langchaingo_vectorstore_documents_total{operation="similarity_search",service="test",store="good"} 1
+# HELP langchaingo_vectorstore_in_flight_operations Number of vector store operations currently in progress by store.
+# TYPE langchaingo_vectorstore_in_flight_operations gauge
+langchaingo_vectorstore_in_flight_operations{service="test",store="bad"} 0
+langchaingo_vectorstore_in_flight_operations{service="test",store="good"} 0
+`
+	require.NoError(t, testutil.GatherAndCompare(registry, strings.NewReader(expected),
+		"langchaingo_vectorstore_operations_total",
+		"langchaingo_vectorstore_documents_total",
+		"langchaingo_vectorstore_in_flight_operations",
+	))
+
+	assert.Equal(t, 3, testutil.CollectAndCount(collector, "langchaingo_vectorstore_operation_duration_seconds"))
+}
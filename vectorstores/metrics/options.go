@@ -0,0 +1,36 @@
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+const defaultNamespace = "langchaingo"
+
+type options struct {
+	namespace   string
+	buckets     []float64
+	constLabels prometheus.Labels
+}
+
+// Option is a function that configures a Collector.
+type Option func(*options)
+
+// WithNamespace sets the metric namespace. Defaults to "langchaingo".
+func WithNamespace(namespace string) Option {
+	return func(o *options) {
+		o.namespace = namespace
+	}
+}
+
+// WithBuckets sets the latency histogram buckets, in seconds. Defaults to
+// prometheus.DefBuckets.
+func WithBuckets(buckets []float64) Option {
+	return func(o *options) {
+		o.buckets = buckets
+	}
+}
+
+// WithConstLabels sets labels added to every metric, such as a service name.
+func WithConstLabels(labels prometheus.Labels) Option {
+	return func(o *options) {
+		o.constLabels = labels
+	}
+}
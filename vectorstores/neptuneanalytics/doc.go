@@ -0,0 +1,8 @@
+// Package neptuneanalytics contains an implementation of the VectorStore
+// interface using Amazon Neptune Analytics.
+//
+// The graph must have been created with a vector search configuration whose
+// dimension matches the embedder. Documents are stored as nodes and their
+// embeddings are written with neptune.algo.vectors.upsert; searches use
+// neptune.algo.vectors.topKByEmbedding through the openCypher ExecuteQuery API.
+package neptuneanalytics
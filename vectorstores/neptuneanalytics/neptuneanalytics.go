@@ -0,0 +1,279 @@
+package neptuneanalytics
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/neptunegraph"
+	"github.com/aws/aws-sdk-go-v2/service/neptunegraph/document"
+	"github.com/aws/aws-sdk-go-v2/service/neptunegraph/types"
+	"github.com/google/uuid"
+	"github.com/tmc/langchaingo/embeddings"
+	"github.com/tmc/langchaingo/schema"
+	"github.com/tmc/langchaingo/vectorstores"
+)
+
+var (
+	ErrEmbedderWrongNumberVectors = errors.New("number of vectors from embedder does not match number of documents")
+	ErrInvalidScoreThreshold      = errors.New("score threshold must be between 0 and 1")
+	ErrUnsupportedOptions         = errors.New("unsupported options")
+)
+
+const (
+	metadataProperty  = "metadata"
+	namespaceProperty = "namespace"
+)
+
+// NeptuneGraphAPI is the subset of the Neptune Analytics client used by the
+// store. It is satisfied by *neptunegraph.Client.
+type NeptuneGraphAPI interface {
+	ExecuteQuery(ctx context.Context, params *neptunegraph.ExecuteQueryInput, optFns ...func(*neptunegraph.Options)) (*neptunegraph.ExecuteQueryOutput, error)
+}
+
+// Store is a wrapper around the Neptune Analytics client.
+type Store struct {
+	embedder        embeddings.Embedder
+	client          NeptuneGraphAPI
+	graphIdentifier string
+	nodeLabel       string
+	textProperty    string
+	fetchMultiplier int
+}
+
+var _ vectorstores.VectorStore = Store{}
+
+// New creates a new Store with options.
+func New(ctx context.Context, opts ...Option) (Store, error) {
+	store, err := applyClientOptions(opts...)
+	if err != nil {
+		return Store{}, err
+	}
+	if store.client == nil {
+		cfg, err := config.LoadDefaultConfig(ctx)
+		if err != nil {
+			return Store{}, fmt.Errorf("failed to load aws config: %w", err)
+		}
+		store.client = neptunegraph.NewFromConfig(cfg)
+	}
+	return store, nil
+}
+
+// AddDocuments adds documents as nodes to the graph, upserts their embeddings
+// into the graph's vector index and returns the ids of the added documents.
+// Metadata is stored as a JSON string property.
+func (s Store) AddDocuments(
+	ctx context.Context,
+	docs []schema.Document,
+	options ...vectorstores.Option,
+) ([]string, error) {
+	opts := s.getOptions(options...)
+	if opts.Filters != nil {
+		return nil, ErrUnsupportedOptions
+	}
+
+	docs = s.deduplicate(ctx, opts, docs)
+	if len(docs) == 0 {
+		return []string{}, nil
+	}
+
+	texts := make([]string, 0, len(docs))
+	for _, doc := range docs {
+		texts = append(texts, doc.PageContent)
+	}
+
+	vectors, err := s.getEmbedder(opts).EmbedDocuments(ctx, texts)
+	if err != nil {
+		return nil, err
+	}
+	if len(vectors) != len(docs) {
+		return nil, ErrEmbedderWrongNumberVectors
+	}
+
+	ids := make([]string, len(docs))
+	rows := make([]map[string]any, 0, len(docs))
+	for i, doc := range docs {
+		ids[i] = uuid.New().String()
+		metadata, err := json.Marshal(doc.Metadata)
+		if err != nil {
+			return nil, err
+		}
+		row := map[string]any{
+			"id":        ids[i],
+			"text":      doc.PageContent,
+			"metadata":  string(metadata),
+			"embedding": vectors[i],
+		}
+		if opts.NameSpace != "" {
+			row["namespace"] = opts.NameSpace
+		}
+		rows = append(rows, row)
+	}
+
+	query := fmt.Sprintf("UNWIND $rows AS row\n"+
+		"MERGE (n:`%s` {`~id`: row.id})\n"+
+		"SET n.`%s` = row.text, n.%s = row.metadata, n.%s = row.namespace\n"+
+		"WITH n, row\n"+
+		"CALL neptune.algo.vectors.upsert(n, row.embedding) YIELD success\n"+
+		"RETURN count(success) AS upserted",
+		s.nodeLabel, s.textProperty, metadataProperty, namespaceProperty)
+
+	if err := s.executeQuery(ctx, query, map[string]any{"rows": rows}, nil); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// SimilaritySearch performs a vector similarity search. Neptune Analytics
+// reports distances, which are returned as scores of 1/(1+distance) so that
+// higher is more similar.
+func (s Store) SimilaritySearch(
+	ctx context.Context,
+	query string,
+	numDocuments int,
+	options ...vectorstores.Option,
+) ([]schema.Document, error) {
+	opts := s.getOptions(options...)
+	if opts.Filters != nil {
+		return nil, ErrUnsupportedOptions
+	}
+	scoreThreshold, err := s.getScoreThreshold(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	vector, err := s.getEmbedder(opts).EmbedQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	cypher, params := s.buildSearchQuery(vector, numDocuments, opts.NameSpace)
+	var results []searchResult
+	if err := s.executeQuery(ctx, cypher, params, &results); err != nil {
+		return nil, err
+	}
+
+	docs := make([]schema.Document, 0, len(results))
+	for _, result := range results {
+		score := float32(1 / (1 + result.Score))
+		if scoreThreshold != 0 && score < scoreThreshold {
+			continue
+		}
+		doc := schema.Document{PageContent: result.Text, Score: score}
+		if result.Metadata != "" {
+			if err := json.Unmarshal([]byte(result.Metadata), &doc.Metadata); err != nil {
+				return nil, err
+			}
+		}
+		docs = append(docs, doc)
+	}
+	return docs, nil
+}
+
+func (s Store) buildSearchQuery(vector []float32, numDocuments int, namespace string) (string, map[string]any) {
+	params := map[string]any{
+		"embedding": vector,
+		"limit":     numDocuments * s.fetchMultiplier,
+		"k":         numDocuments,
+	}
+
+	conditions := []string{fmt.Sprintf("node:`%s`", s.nodeLabel)}
+	if namespace != "" {
+		conditions = append(conditions, "node."+namespaceProperty+" = $namespace")
+		params["namespace"] = namespace
+	}
+
+	var b strings.Builder
+	b.WriteString("CALL neptune.algo.vectors.topKByEmbedding($embedding, {topK: $limit})\n")
+	b.WriteString("YIELD node, score\n")
+	b.WriteString("WITH node, score\n")
+	b.WriteString("WHERE " + strings.Join(conditions, " AND ") + "\n")
+	fmt.Fprintf(&b, "RETURN node.`%s` AS text, node.%s AS metadata, score\n", s.textProperty, metadataProperty)
+	b.WriteString("ORDER BY score ASC\nLIMIT $k")
+	return b.String(), params
+}
+
+type searchResult struct {
+	Text     string  `json:"text"`
+	Metadata string  `json:"metadata"`
+	Score    float64 `json:"score"`
+}
+
+// executeQuery runs an openCypher query and, if results is not nil, decodes the
+// "results" array of the response payload into it.
+func (s Store) executeQuery(ctx context.Context, query string, params map[string]any, results any) error {
+	parameters := make(map[string]document.Interface, len(params))
+	for k, v := range params {
+		parameters[k] = document.NewLazyDocument(v)
+	}
+
+	out, err := s.client.ExecuteQuery(ctx, &neptunegraph.ExecuteQueryInput{
+		GraphIdentifier: aws.String(s.graphIdentifier),
+		Language:        types.QueryLanguageOpenCypher,
+		QueryString:     aws.String(query),
+		Parameters:      parameters,
+	})
+	if err != nil {
+		return err
+	}
+	defer out.Payload.Close()
+
+	if results == nil {
+		return nil
+	}
+	var payload struct {
+		Results json.RawMessage `json:"results"`
+	}
+	if err := json.NewDecoder(out.Payload).Decode(&payload); err != nil {
+		return fmt.Errorf("decoding query payload: %w", err)
+	}
+	if len(payload.Results) == 0 {
+		return nil
+	}
+	return json.Unmarshal(payload.Results, results)
+}
+
+func (s Store) getOptions(options ...vectorstores.Option) vectorstores.Options {
+	opts := vectorstores.Options{}
+	for _, opt := range options {
+		opt(&opts)
+	}
+	return opts
+}
+
+func (s Store) getEmbedder(opts vectorstores.Options) embeddings.Embedder {
+	if opts.Embedder != nil {
+		return opts.Embedder
+	}
+	return s.embedder
+}
+
+func (s Store) getScoreThreshold(opts vectorstores.Options) (float32, error) {
+	if opts.ScoreThreshold < 0 || opts.ScoreThreshold > 1 {
+		return 0, ErrInvalidScoreThreshold
+	}
+	return opts.ScoreThreshold, nil
+}
+
+func (s Store) deduplicate(
+	ctx context.Context,
+	opts vectorstores.Options,
+	docs []schema.Document,
+) []schema.Document {
+	if opts.Deduplicater == nil {
+		return docs
+	}
+
+	filtered := make([]schema.Document, 0, len(docs))
+	for _, doc := range docs {
+		if !opts.Deduplicater(ctx, doc) {
+			filtered = append(filtered, doc)
+		}
+	}
+
+	return filtered
+}
@@ -0,0 +1,139 @@
+package neptuneanalytics
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/neptunegraph"
+	"github.com/aws/aws-sdk-go-v2/service/neptunegraph/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tmc/langchaingo/schema"
+	"github.com/tmc/langchaingo/vectorstores"
+)
+
+type testEmbedder struct{}
+
+func (testEmbedder) EmbedDocuments(_ context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, len(texts))
+	for i, text := range texts {
+		vectors[i] = []float32{float32(len(text)), 1}
+	}
+	return vectors, nil
+}
+
+func (testEmbedder) EmbedQuery(_ context.Context, text string) ([]float32, error) {
+	return []float32{float32(len(text)), 1}, nil
+}
+
+type testNeptuneGraph struct {
+	inputs  []*neptunegraph.ExecuteQueryInput
+	payload string
+	err     error
+}
+
+var _ NeptuneGraphAPI = &testNeptuneGraph{}
+
+func (t *testNeptuneGraph) ExecuteQuery(_ context.Context, params *neptunegraph.ExecuteQueryInput, _ ...func(*neptunegraph.Options)) (*neptunegraph.ExecuteQueryOutput, error) {
+	t.inputs = append(t.inputs, params)
+	if t.err != nil {
+		return nil, t.err
+	}
+	return &neptunegraph.ExecuteQueryOutput{Payload: io.NopCloser(strings.NewReader(t.payload))}, nil
+}
+
+func newTestStore(t *testing.T, client *testNeptuneGraph) Store {
+	t.Helper()
+	store, err := New(context.Background(),
+		WithGraphIdentifier("g-test"),
+		WithEmbedder(testEmbedder{}),
+		WithClient(client),
+	)
+	require.NoError(t, err)
+	return store
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	_, err := New(context.Background(), WithEmbedder(testEmbedder{}), WithClient(&testNeptuneGraph{}))
+	require.ErrorIs(t, err, ErrInvalidOptions)
+	assert.Contains(t, err.Error(), "missing graph identifier")
+
+	_, err = New(context.Background(), WithGraphIdentifier("g-test"), WithClient(&testNeptuneGraph{}))
+	require.ErrorIs(t, err, ErrInvalidOptions)
+	assert.Contains(t, err.Error(), "missing embedder")
+
+	_, err = New(context.Background(), WithGraphIdentifier("g-test"), WithEmbedder(testEmbedder{}),
+		WithClient(&testNeptuneGraph{}), WithNodeLabel("Chunk` {x: 1}) DETACH DELETE n //"))
+	require.ErrorIs(t, err, ErrInvalidOptions)
+}
+
+func TestAddDocuments(t *testing.T) {
+	t.Parallel()
+
+	client := &testNeptuneGraph{payload: `{"results":[{"upserted":2}]}`}
+	store := newTestStore(t, client)
+
+	ids, err := store.AddDocuments(context.Background(), []schema.Document{
+		{PageContent: "tokyo", Metadata: map[string]any{"country": "japan"}},
+		{PageContent: "potato"},
+	}, vectorstores.WithNameSpace("ns"))
+	require.NoError(t, err)
+	require.Len(t, ids, 2)
+
+	require.Len(t, client.inputs, 1)
+	input := client.inputs[0]
+	assert.Equal(t, "g-test", aws.ToString(input.GraphIdentifier))
+	assert.Equal(t, types.QueryLanguageOpenCypher, input.Language)
+	assert.Contains(t, aws.ToString(input.QueryString), "neptune.algo.vectors.upsert(n, row.embedding)")
+	assert.Contains(t, input.Parameters, "rows")
+
+	_, err = store.AddDocuments(context.Background(), []schema.Document{{PageContent: "x"}},
+		vectorstores.WithFilters(map[string]any{"a": 1}))
+	require.ErrorIs(t, err, ErrUnsupportedOptions)
+}
+
+func TestSimilaritySearch(t *testing.T) {
+	t.Parallel()
+
+	client := &testNeptuneGraph{payload: `{"results":[
+		{"text":"tokyo","metadata":"{\"country\":\"japan\"}","score":0},
+		{"text":"potato","metadata":"null","score":3}
+	]}`}
+	store := newTestStore(t, client)
+
+	docs, err := store.SimilaritySearch(context.Background(), "japan", 2, vectorstores.WithNameSpace("ns"))
+	require.NoError(t, err)
+	require.Len(t, docs, 2)
+	assert.Equal(t, "tokyo", docs[0].PageContent)
+	assert.Equal(t, "japan", docs[0].Metadata["country"])
+	assert.InDelta(t, 1.0, docs[0].Score, 1e-6)
+	assert.InDelta(t, 0.25, docs[1].Score, 1e-6)
+
+	query := aws.ToString(client.inputs[0].QueryString)
+	assert.Contains(t, query, "topKByEmbedding($embedding, {topK: $limit})")
+	assert.Contains(t, query, "WHERE node:`Chunk` AND node.namespace = $namespace")
+	assert.Contains(t, client.inputs[0].Parameters, "namespace")
+
+	docs, err = store.SimilaritySearch(context.Background(), "japan", 2, vectorstores.WithScoreThreshold(0.5))
+	require.NoError(t, err)
+	require.Len(t, docs, 1)
+
+	_, err = store.SimilaritySearch(context.Background(), "japan", 2, vectorstores.WithScoreThreshold(2))
+	require.ErrorIs(t, err, ErrInvalidScoreThreshold)
+}
+
+func TestSimilaritySearchError(t *testing.T) {
+	t.Parallel()
+
+	client := &testNeptuneGraph{err: errors.New("access denied")}
+	store := newTestStore(t, client)
+
+	_, err := store.SimilaritySearch(context.Background(), "japan", 2)
+	require.ErrorContains(t, err, "access denied")
+}
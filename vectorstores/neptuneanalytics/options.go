@@ -0,0 +1,105 @@
+package neptuneanalytics
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/tmc/langchaingo/embeddings"
+)
+
+const (
+	defaultNodeLabel       = "Chunk"
+	defaultTextProperty    = "text"
+	defaultFetchMultiplier = 4
+)
+
+// ErrInvalidOptions is returned when the options given are invalid.
+var ErrInvalidOptions = errors.New("invalid options")
+
+var identifierRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// Option is a function type that can be used to modify the client.
+type Option func(p *Store)
+
+// WithGraphIdentifier is an option for specifying the Neptune Analytics graph
+// identifier, e.g. "g-abc123". Must be set.
+func WithGraphIdentifier(id string) Option {
+	return func(p *Store) {
+		p.graphIdentifier = id
+	}
+}
+
+// WithEmbedder is an option for setting the embedder to use. Must be set.
+func WithEmbedder(e embeddings.Embedder) Option {
+	return func(p *Store) {
+		p.embedder = e
+	}
+}
+
+// WithClient is an option for specifying the Neptune Analytics client, for
+// example one created with neptunegraph.NewFromConfig. When not set, a client
+// is created from the default AWS configuration.
+func WithClient(client NeptuneGraphAPI) Option {
+	return func(p *Store) {
+		p.client = client
+	}
+}
+
+// WithNodeLabel is an option for specifying the label of document nodes.
+// Defaults to "Chunk".
+func WithNodeLabel(label string) Option {
+	return func(p *Store) {
+		p.nodeLabel = label
+	}
+}
+
+// WithTextProperty is an option for specifying the node property holding the
+// page content. Defaults to "text".
+func WithTextProperty(name string) Option {
+	return func(p *Store) {
+		p.textProperty = name
+	}
+}
+
+// WithFetchMultiplier is an option for specifying how many more candidates than
+// requested are fetched from the vector index. The index covers every node in
+// the graph, so results are narrowed to the document label and namespace after
+// the lookup. Defaults to 4.
+func WithFetchMultiplier(multiplier int) Option {
+	return func(p *Store) {
+		p.fetchMultiplier = multiplier
+	}
+}
+
+func applyClientOptions(opts ...Option) (Store, error) {
+	o := &Store{
+		nodeLabel:       defaultNodeLabel,
+		textProperty:    defaultTextProperty,
+		fetchMultiplier: defaultFetchMultiplier,
+	}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if o.graphIdentifier == "" {
+		return Store{}, fmt.Errorf("%w: missing graph identifier", ErrInvalidOptions)
+	}
+
+	if o.embedder == nil {
+		return Store{}, fmt.Errorf("%w: missing embedder", ErrInvalidOptions)
+	}
+
+	for _, name := range []string{o.nodeLabel, o.textProperty} {
+		if !identifierRe.MatchString(name) {
+			return Store{}, fmt.Errorf("%w: invalid identifier %q", ErrInvalidOptions, name)
+		}
+	}
+
+	if o.fetchMultiplier <= 0 {
+		return Store{}, fmt.Errorf("%w: fetch multiplier must be positive", ErrInvalidOptions)
+	}
+
+	return *o, nil
+}
@@ -0,0 +1,8 @@
+// Package ratelimit provides a VectorStore wrapper that bounds the load
+// placed on the underlying database.
+//
+// The wrapper can cap the number of operations in flight and the rate at
+// which operations start. Callers that exceed either budget queue until a
+// slot is free, their context is done, or the configured wait timeout
+// elapses, which protects shared databases from bursts of agent traffic.
+package ratelimit
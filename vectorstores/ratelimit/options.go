@@ -0,0 +1,35 @@
+package ratelimit
+
+import (
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Option is a function that configures a Store.
+type Option func(*Store)
+
+// WithMaxInFlight limits the number of operations that may run concurrently.
+// Zero, the default, means no limit.
+func WithMaxInFlight(n int64) Option {
+	return func(s *Store) {
+		s.maxInFlight = n
+	}
+}
+
+// WithRate limits how many operations may start per second, allowing bursts
+// of up to burst operations. By default the start rate is unlimited.
+func WithRate(perSecond float64, burst int) Option {
+	return func(s *Store) {
+		s.limiter = rate.NewLimiter(rate.Limit(perSecond), burst)
+	}
+}
+
+// WithWaitTimeout bounds how long an operation may queue for a slot before
+// failing with ErrLimitExceeded. By default operations wait until their
+// context is done.
+func WithWaitTimeout(d time.Duration) Option {
+	return func(s *Store) {
+		s.waitTimeout = d
+	}
+}
@@ -0,0 +1,97 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/tmc/langchaingo/schema"
+	"github.com/tmc/langchaingo/vectorstores"
+	"golang.org/x/sync/semaphore"
+	"golang.org/x/time/rate"
+)
+
+// ErrLimitExceeded is returned when an operation could not acquire a slot
+// within the configured wait timeout.
+var ErrLimitExceeded = errors.New("vector store rate limit exceeded")
+
+// Store is a VectorStore wrapper enforcing concurrency and rate limits.
+type Store struct {
+	store       vectorstores.VectorStore
+	maxInFlight int64
+	limiter     *rate.Limiter
+	waitTimeout time.Duration
+	sem         *semaphore.Weighted
+}
+
+var _ vectorstores.VectorStore = (*Store)(nil)
+
+// New wraps store with the limits given by opts.
+func New(store vectorstores.VectorStore, opts ...Option) *Store {
+	s := &Store{store: store}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.maxInFlight > 0 {
+		s.sem = semaphore.NewWeighted(s.maxInFlight)
+	}
+	return s
+}
+
+// AddDocuments adds documents to the wrapped store once a slot is available.
+func (s *Store) AddDocuments(ctx context.Context, docs []schema.Document, options ...vectorstores.Option) ([]string, error) {
+	release, err := s.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return s.store.AddDocuments(ctx, docs, options...)
+}
+
+// SimilaritySearch searches the wrapped store once a slot is available.
+func (s *Store) SimilaritySearch(ctx context.Context, query string, numDocuments int, options ...vectorstores.Option) ([]schema.Document, error) { //nolint:lll
+	release, err := s.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return s.store.SimilaritySearch(ctx, query, numDocuments, options...)
+}
+
+// acquire waits for both an in-flight slot and a rate token. The wait timeout
+// only applies to queuing; the operation itself runs with the caller's ctx.
+func (s *Store) acquire(ctx context.Context) (func(), error) {
+	waitCtx := ctx
+	if s.waitTimeout > 0 {
+		var cancel context.CancelFunc
+		waitCtx, cancel = context.WithTimeout(ctx, s.waitTimeout)
+		defer cancel()
+	}
+
+	release := func() {}
+	if s.sem != nil {
+		if err := s.sem.Acquire(waitCtx, 1); err != nil {
+			return nil, s.waitError(ctx, err)
+		}
+		release = func() { s.sem.Release(1) }
+	}
+
+	if s.limiter != nil {
+		if err := s.limiter.Wait(waitCtx); err != nil {
+			release()
+			return nil, s.waitError(ctx, err)
+		}
+	}
+	return release, nil
+}
+
+// waitError reports the caller's own cancellation as is, and anything caused
+// by the wait timeout, or by a wait that could never succeed in time, as
+// ErrLimitExceeded.
+func (s *Store) waitError(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return fmt.Errorf("%w: %w", ErrLimitExceeded, err)
+}
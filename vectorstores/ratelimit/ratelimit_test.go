@@ -0,0 +1,105 @@
+package ratelimit_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tmc/langchaingo/schema"
+	"github.com/tmc/langchaingo/vectorstores"
+	"github.com/tmc/langchaingo/vectorstores/ratelimit"
+)
+
+// blockingStore blocks every call until release is closed and records the
+// highest number of concurrent calls it has seen.
+type blockingStore struct {
+	release  chan struct{}
+	inFlight atomic.Int64
+	peak     atomic.Int64
+}
+
+func (s *blockingStore) enter() {
+	n := s.inFlight.Add(1)
+	for {
+		peak := s.peak.Load()
+		if n <= peak || s.peak.CompareAndSwap(peak, n) {
+			break
+		}
+	}
+	<-s.release
+	s.inFlight.Add(-1)
+}
+
+func (s *blockingStore) AddDocuments(_ context.Context, docs []schema.Document, _ ...vectorstores.Option) ([]string, error) {
+	s.enter()
+	return make([]string, len(docs)), nil
+}
+
+func (s *blockingStore) SimilaritySearch(_ context.Context, _ string, _ int, _ ...vectorstores.Option) ([]schema.Document, error) {
+	s.enter()
+	return nil, nil
+}
+
+func TestMaxInFlight(t *testing.T) {
+	t.Parallel()
+
+	inner := &blockingStore{release: make(chan struct{})}
+	store := ratelimit.New(inner, ratelimit.WithMaxInFlight(2))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := store.SimilaritySearch(context.Background(), "q", 1)
+			assert.NoError(t, err)
+		}()
+	}
+
+	require.Eventually(t, func() bool { return inner.inFlight.Load() == 2 }, time.Second, time.Millisecond)
+	close(inner.release)
+	wg.Wait()
+	assert.Equal(t, int64(2), inner.peak.Load())
+}
+
+func TestWaitTimeout(t *testing.T) {
+	t.Parallel()
+
+	inner := &blockingStore{release: make(chan struct{})}
+	store := ratelimit.New(inner, ratelimit.WithMaxInFlight(1), ratelimit.WithWaitTimeout(10*time.Millisecond))
+
+	go func() {
+		_, _ = store.AddDocuments(context.Background(), []schema.Document{{}})
+	}()
+	require.Eventually(t, func() bool { return inner.inFlight.Load() == 1 }, time.Second, time.Millisecond)
+
+	_, err := store.AddDocuments(context.Background(), []schema.Document{{}})
+	require.ErrorIs(t, err, ratelimit.ErrLimitExceeded)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = store.AddDocuments(ctx, []schema.Document{{}})
+	require.ErrorIs(t, err, context.Canceled)
+	require.NotErrorIs(t, err, ratelimit.ErrLimitExceeded)
+
+	close(inner.release)
+}
+
+func TestRate(t *testing.T) {
+	t.Parallel()
+
+	inner := &blockingStore{release: make(chan struct{})}
+	close(inner.release)
+	store := ratelimit.New(inner, ratelimit.WithRate(1, 1), ratelimit.WithWaitTimeout(50*time.Millisecond))
+
+	_, err := store.SimilaritySearch(context.Background(), "q", 1)
+	require.NoError(t, err)
+
+	// The next token is a second away, well past the wait timeout.
+	_, err = store.SimilaritySearch(context.Background(), "q", 1)
+	require.ErrorIs(t, err, ratelimit.ErrLimitExceeded)
+}
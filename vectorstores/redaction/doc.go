@@ -0,0 +1,9 @@
+// Package redaction provides a VectorStore wrapper that rewrites documents
+// before they are stored.
+//
+// Each Transform sees a document on its way into AddDocuments and returns the
+// version that should be embedded and written instead. Typical uses are
+// removing personal data for compliance pipelines or normalizing metadata.
+// Transforms run in the order given, and any error aborts the whole batch
+// before the wrapped store is called.
+package redaction
@@ -0,0 +1,134 @@
+package redaction
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/tmc/langchaingo/schema"
+	"github.com/tmc/langchaingo/vectorstores"
+)
+
+// Transform rewrites a document before it is added. The metadata it is given,
+// including nested maps and slices, is a copy of the caller's, so it may be
+// modified in place.
+type Transform func(ctx context.Context, doc schema.Document) (schema.Document, error)
+
+// Store is a VectorStore wrapper applying transforms to added documents.
+type Store struct {
+	store      vectorstores.VectorStore
+	transforms []Transform
+}
+
+var _ vectorstores.VectorStore = (*Store)(nil)
+
+// New wraps store so that transforms run over every document passed to
+// AddDocuments.
+func New(store vectorstores.VectorStore, transforms ...Transform) *Store {
+	return &Store{store: store, transforms: transforms}
+}
+
+// AddDocuments transforms docs and adds the result to the wrapped store. The
+// caller's documents are left unchanged.
+func (s *Store) AddDocuments(ctx context.Context, docs []schema.Document, options ...vectorstores.Option) ([]string, error) {
+	transformed := make([]schema.Document, len(docs))
+	for i, doc := range docs {
+		doc.Metadata = copyMetadata(doc.Metadata)
+		for _, transform := range s.transforms {
+			var err error
+			if doc, err = transform(ctx, doc); err != nil {
+				return nil, fmt.Errorf("transforming document %d: %w", i, err)
+			}
+		}
+		transformed[i] = doc
+	}
+	return s.store.AddDocuments(ctx, transformed, options...)
+}
+
+// SimilaritySearch searches the wrapped store. Queries are not transformed
+// since they are never stored.
+func (s *Store) SimilaritySearch(ctx context.Context, query string, numDocuments int, options ...vectorstores.Option) ([]schema.Document, error) { //nolint:lll
+	return s.store.SimilaritySearch(ctx, query, numDocuments, options...)
+}
+
+// Text returns a Transform that applies fn to the page content and to every
+// string in the metadata, including strings nested in maps and slices.
+func Text(fn func(string) string) Transform {
+	return func(_ context.Context, doc schema.Document) (schema.Document, error) {
+		doc.PageContent = fn(doc.PageContent)
+		for k, v := range doc.Metadata {
+			doc.Metadata[k] = mapStrings(v, fn)
+		}
+		return doc, nil
+	}
+}
+
+// Regexp returns a Transform that replaces every match of re in the page
+// content and string metadata values with replacement, which may refer to
+// submatches as in regexp.Regexp.ReplaceAllString.
+func Regexp(re *regexp.Regexp, replacement string) Transform {
+	return Text(func(s string) string {
+		return re.ReplaceAllString(s, replacement)
+	})
+}
+
+// DropMetadata returns a Transform that removes the given metadata keys.
+func DropMetadata(keys ...string) Transform {
+	return func(_ context.Context, doc schema.Document) (schema.Document, error) {
+		for _, k := range keys {
+			delete(doc.Metadata, k)
+		}
+		return doc, nil
+	}
+}
+
+// copyMetadata returns a deep copy of metadata, so that transforms can modify
+// nested values without touching the caller's document.
+func copyMetadata(metadata map[string]any) map[string]any {
+	if metadata == nil {
+		return nil
+	}
+	copied, _ := mapStrings(metadata, func(s string) string { return s }).(map[string]any)
+	return copied
+}
+
+// mapStrings returns a copy of v with fn applied to every string it contains.
+// Maps and slices are copied recursively; other values are returned as is.
+func mapStrings(v any, fn func(string) string) any {
+	switch v := v.(type) {
+	case string:
+		return fn(v)
+	case map[string]any:
+		m := make(map[string]any, len(v))
+		for k, item := range v {
+			m[k] = mapStrings(item, fn)
+		}
+		return m
+	case map[string]string:
+		m := make(map[string]string, len(v))
+		for k, item := range v {
+			m[k] = fn(item)
+		}
+		return m
+	case []any:
+		s := make([]any, len(v))
+		for i, item := range v {
+			s[i] = mapStrings(item, fn)
+		}
+		return s
+	case []string:
+		s := make([]string, len(v))
+		for i, item := range v {
+			s[i] = fn(item)
+		}
+		return s
+	case []map[string]any:
+		s := make([]map[string]any, len(v))
+		for i, item := range v {
+			s[i], _ = mapStrings(item, fn).(map[string]any)
+		}
+		return s
+	default:
+		return v
+	}
+}
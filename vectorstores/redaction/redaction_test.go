@@ -0,0 +1,123 @@
+package redaction_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tmc/langchaingo/schema"
+	"github.com/tmc/langchaingo/vectorstores"
+	"github.com/tmc/langchaingo/vectorstores/redaction"
+)
+
+type recordingStore struct {
+	added []schema.Document
+	query string
+}
+
+func (s *recordingStore) AddDocuments(_ context.Context, docs []schema.Document, _ ...vectorstores.Option) ([]string, error) {
+	s.added = append(s.added, docs...)
+	return make([]string, len(docs)), nil
+}
+
+func (s *recordingStore) SimilaritySearch(_ context.Context, query string, _ int, _ ...vectorstores.Option) ([]schema.Document, error) {
+	s.query = query
+	return nil, nil
+}
+
+func TestAddDocuments(t *testing.T) {
+	t.Parallel()
+
+	inner := &recordingStore{}
+	email := regexp.MustCompile(`[\w.+-]+@[\w-]+\.[\w.]+`)
+	store := redaction.New(inner,
+		redaction.Regexp(email, "[EMAIL]"),
+		redaction.DropMetadata("ssn"),
+	)
+
+	docs := []schema.Document{{
+		PageContent: "contact jane@example.com for details",
+		Metadata:    map[string]any{"author": "bob@example.org", "ssn": "123-45-6789", "page": 3},
+	}}
+	ids, err := store.AddDocuments(context.Background(), docs)
+	require.NoError(t, err)
+	require.Len(t, ids, 1)
+
+	require.Len(t, inner.added, 1)
+	assert.Equal(t, "contact [EMAIL] for details", inner.added[0].PageContent)
+	assert.Equal(t, map[string]any{"author": "[EMAIL]", "page": 3}, inner.added[0].Metadata)
+
+	// The caller's documents are untouched.
+	assert.Equal(t, "contact jane@example.com for details", docs[0].PageContent)
+	assert.Equal(t, "123-45-6789", docs[0].Metadata["ssn"])
+
+	_, err = store.SimilaritySearch(context.Background(), "jane@example.com", 1)
+	require.NoError(t, err)
+	assert.Equal(t, "jane@example.com", inner.query)
+}
+
+func TestAddDocumentsNestedMetadata(t *testing.T) {
+	t.Parallel()
+
+	inner := &recordingStore{}
+	email := regexp.MustCompile(`[\w.+-]+@[\w-]+\.[\w.]+`)
+	store := redaction.New(inner,
+		redaction.Regexp(email, "[EMAIL]"),
+		func(_ context.Context, doc schema.Document) (schema.Document, error) {
+			doc.Metadata["contact"].(map[string]any)["reviewed"] = true
+			return doc, nil
+		},
+	)
+
+	docs := []schema.Document{{
+		PageContent: "notes",
+		Metadata: map[string]any{
+			"contact": map[string]any{
+				"email":   "jane@example.com",
+				"aliases": []any{"j@example.com", map[string]any{"work": "jd@corp.example.com"}},
+			},
+			"cc": []string{"bob@example.org", "team"},
+		},
+	}}
+	_, err := store.AddDocuments(context.Background(), docs)
+	require.NoError(t, err)
+
+	require.Len(t, inner.added, 1)
+	assert.Equal(t, map[string]any{
+		"contact": map[string]any{
+			"email":    "[EMAIL]",
+			"aliases":  []any{"[EMAIL]", map[string]any{"work": "[EMAIL]"}},
+			"reviewed": true,
+		},
+		"cc": []string{"[EMAIL]", "team"},
+	}, inner.added[0].Metadata)
+
+	// The caller's nested values are untouched.
+	assert.Equal(t, map[string]any{
+		"contact": map[string]any{
+			"email":   "jane@example.com",
+			"aliases": []any{"j@example.com", map[string]any{"work": "jd@corp.example.com"}},
+		},
+		"cc": []string{"bob@example.org", "team"},
+	}, docs[0].Metadata)
+}
+
+func TestAddDocumentsError(t *testing.T) {
+	t.Parallel()
+
+	inner := &recordingStore{}
+	errReject := errors.New("rejected")
+	store := redaction.New(inner, func(_ context.Context, doc schema.Document) (schema.Document, error) {
+		if doc.PageContent == "secret" {
+			return doc, errReject
+		}
+		return doc, nil
+	})
+
+	_, err := store.AddDocuments(context.Background(), []schema.Document{{PageContent: "ok"}, {PageContent: "secret"}})
+	require.ErrorIs(t, err, errReject)
+	assert.Empty(t, inner.added)
+}